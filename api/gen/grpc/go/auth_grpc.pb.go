@@ -21,18 +21,46 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Auth_Login_FullMethodName  = "/ceph.Auth/Login"
-	Auth_Logout_FullMethodName = "/ceph.Auth/Logout"
-	Auth_Check_FullMethodName  = "/ceph.Auth/Check"
+	Auth_Login_FullMethodName        = "/ceph.Auth/Login"
+	Auth_Logout_FullMethodName       = "/ceph.Auth/Logout"
+	Auth_Check_FullMethodName        = "/ceph.Auth/Check"
+	Auth_ListClients_FullMethodName  = "/ceph.Auth/ListClients"
+	Auth_GetClient_FullMethodName    = "/ceph.Auth/GetClient"
+	Auth_CreateClient_FullMethodName = "/ceph.Auth/CreateClient"
+	Auth_UpdateClient_FullMethodName = "/ceph.Auth/UpdateClient"
+	Auth_DeleteClient_FullMethodName = "/ceph.Auth/DeleteClient"
+	Auth_EnrollMFA_FullMethodName    = "/ceph.Auth/EnrollMFA"
+	Auth_VerifyMFA_FullMethodName    = "/ceph.Auth/VerifyMFA"
+	Auth_DisableMFA_FullMethodName   = "/ceph.Auth/DisableMFA"
+	Auth_UnlockUser_FullMethodName   = "/ceph.Auth/UnlockUser"
+	Auth_WhoAmI_FullMethodName       = "/ceph.Auth/WhoAmI"
+	Auth_CheckAccess_FullMethodName  = "/ceph.Auth/CheckAccess"
 )
 
 // AuthClient is the client API for Auth service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AuthClient interface {
+	// Login and Logout are not audited as API calls, OAuth endpoints record token_issued, login_failed and token_revoked events instead.
 	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error)
 	Logout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Check(ctx context.Context, in *TokenCheckReq, opts ...grpc.CallOption) (*TokenCheckResp, error)
+	ListClients(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*OAuthClientsResp, error)
+	GetClient(ctx context.Context, in *GetOAuthClientReq, opts ...grpc.CallOption) (*OAuthClient, error)
+	CreateClient(ctx context.Context, in *OAuthClient, opts ...grpc.CallOption) (*OAuthClientSecret, error)
+	UpdateClient(ctx context.Context, in *UpdateOAuthClientReq, opts ...grpc.CallOption) (*OAuthClientSecret, error)
+	DeleteClient(ctx context.Context, in *GetOAuthClientReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TOTP multi-factor authentication. Requests are authenticated with user credentials instead of token,
+	// so users required to use MFA can enroll before the first login.
+	EnrollMFA(ctx context.Context, in *MFAReq, opts ...grpc.CallOption) (*MFAEnrollment, error)
+	VerifyMFA(ctx context.Context, in *MFAReq, opts ...grpc.CallOption) (*MFARecoveryCodes, error)
+	DisableMFA(ctx context.Context, in *MFAReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UnlockUser enables user locked after too many failed login attempts.
+	UnlockUser(ctx context.Context, in *UnlockUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// WhoAmI returns identity and effective permissions of request caller
+	WhoAmI(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WhoAmIResp, error)
+	// CheckAccess checks if request caller has given permissions. Cluster is taken from request as for other calls.
+	CheckAccess(ctx context.Context, in *CheckAccessReq, opts ...grpc.CallOption) (*CheckAccessResp, error)
 }
 
 type authClient struct {
@@ -73,13 +101,140 @@ func (c *authClient) Check(ctx context.Context, in *TokenCheckReq, opts ...grpc.
 	return out, nil
 }
 
+func (c *authClient) ListClients(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*OAuthClientsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthClientsResp)
+	err := c.cc.Invoke(ctx, Auth_ListClients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) GetClient(ctx context.Context, in *GetOAuthClientReq, opts ...grpc.CallOption) (*OAuthClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthClient)
+	err := c.cc.Invoke(ctx, Auth_GetClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) CreateClient(ctx context.Context, in *OAuthClient, opts ...grpc.CallOption) (*OAuthClientSecret, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthClientSecret)
+	err := c.cc.Invoke(ctx, Auth_CreateClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) UpdateClient(ctx context.Context, in *UpdateOAuthClientReq, opts ...grpc.CallOption) (*OAuthClientSecret, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthClientSecret)
+	err := c.cc.Invoke(ctx, Auth_UpdateClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) DeleteClient(ctx context.Context, in *GetOAuthClientReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Auth_DeleteClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) EnrollMFA(ctx context.Context, in *MFAReq, opts ...grpc.CallOption) (*MFAEnrollment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFAEnrollment)
+	err := c.cc.Invoke(ctx, Auth_EnrollMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) VerifyMFA(ctx context.Context, in *MFAReq, opts ...grpc.CallOption) (*MFARecoveryCodes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFARecoveryCodes)
+	err := c.cc.Invoke(ctx, Auth_VerifyMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) DisableMFA(ctx context.Context, in *MFAReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Auth_DisableMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) UnlockUser(ctx context.Context, in *UnlockUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Auth_UnlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) WhoAmI(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WhoAmIResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WhoAmIResp)
+	err := c.cc.Invoke(ctx, Auth_WhoAmI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) CheckAccess(ctx context.Context, in *CheckAccessReq, opts ...grpc.CallOption) (*CheckAccessResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAccessResp)
+	err := c.cc.Invoke(ctx, Auth_CheckAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServer is the server API for Auth service.
 // All implementations should embed UnimplementedAuthServer
 // for forward compatibility.
 type AuthServer interface {
+	// Login and Logout are not audited as API calls, OAuth endpoints record token_issued, login_failed and token_revoked events instead.
 	Login(context.Context, *LoginReq) (*LoginResp, error)
 	Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	Check(context.Context, *TokenCheckReq) (*TokenCheckResp, error)
+	ListClients(context.Context, *emptypb.Empty) (*OAuthClientsResp, error)
+	GetClient(context.Context, *GetOAuthClientReq) (*OAuthClient, error)
+	CreateClient(context.Context, *OAuthClient) (*OAuthClientSecret, error)
+	UpdateClient(context.Context, *UpdateOAuthClientReq) (*OAuthClientSecret, error)
+	DeleteClient(context.Context, *GetOAuthClientReq) (*emptypb.Empty, error)
+	// TOTP multi-factor authentication. Requests are authenticated with user credentials instead of token,
+	// so users required to use MFA can enroll before the first login.
+	EnrollMFA(context.Context, *MFAReq) (*MFAEnrollment, error)
+	VerifyMFA(context.Context, *MFAReq) (*MFARecoveryCodes, error)
+	DisableMFA(context.Context, *MFAReq) (*emptypb.Empty, error)
+	// UnlockUser enables user locked after too many failed login attempts.
+	UnlockUser(context.Context, *UnlockUserReq) (*emptypb.Empty, error)
+	// WhoAmI returns identity and effective permissions of request caller
+	WhoAmI(context.Context, *emptypb.Empty) (*WhoAmIResp, error)
+	// CheckAccess checks if request caller has given permissions. Cluster is taken from request as for other calls.
+	CheckAccess(context.Context, *CheckAccessReq) (*CheckAccessResp, error)
 }
 
 // UnimplementedAuthServer should be embedded to have
@@ -98,6 +253,39 @@ func (UnimplementedAuthServer) Logout(context.Context, *emptypb.Empty) (*emptypb
 func (UnimplementedAuthServer) Check(context.Context, *TokenCheckReq) (*TokenCheckResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
 }
+func (UnimplementedAuthServer) ListClients(context.Context, *emptypb.Empty) (*OAuthClientsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
+}
+func (UnimplementedAuthServer) GetClient(context.Context, *GetOAuthClientReq) (*OAuthClient, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClient not implemented")
+}
+func (UnimplementedAuthServer) CreateClient(context.Context, *OAuthClient) (*OAuthClientSecret, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClient not implemented")
+}
+func (UnimplementedAuthServer) UpdateClient(context.Context, *UpdateOAuthClientReq) (*OAuthClientSecret, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClient not implemented")
+}
+func (UnimplementedAuthServer) DeleteClient(context.Context, *GetOAuthClientReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClient not implemented")
+}
+func (UnimplementedAuthServer) EnrollMFA(context.Context, *MFAReq) (*MFAEnrollment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollMFA not implemented")
+}
+func (UnimplementedAuthServer) VerifyMFA(context.Context, *MFAReq) (*MFARecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMFA not implemented")
+}
+func (UnimplementedAuthServer) DisableMFA(context.Context, *MFAReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableMFA not implemented")
+}
+func (UnimplementedAuthServer) UnlockUser(context.Context, *UnlockUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (UnimplementedAuthServer) WhoAmI(context.Context, *emptypb.Empty) (*WhoAmIResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhoAmI not implemented")
+}
+func (UnimplementedAuthServer) CheckAccess(context.Context, *CheckAccessReq) (*CheckAccessResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccess not implemented")
+}
 func (UnimplementedAuthServer) testEmbeddedByValue() {}
 
 // UnsafeAuthServer may be embedded to opt out of forward compatibility for this service.
@@ -172,6 +360,204 @@ func _Auth_Check_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Auth_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ListClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_ListClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ListClients(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_GetClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOAuthClientReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).GetClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_GetClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).GetClient(ctx, req.(*GetOAuthClientReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_CreateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).CreateClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_CreateClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).CreateClient(ctx, req.(*OAuthClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_UpdateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOAuthClientReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).UpdateClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_UpdateClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).UpdateClient(ctx, req.(*UpdateOAuthClientReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_DeleteClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOAuthClientReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).DeleteClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_DeleteClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).DeleteClient(ctx, req.(*GetOAuthClientReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_EnrollMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MFAReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).EnrollMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_EnrollMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).EnrollMFA(ctx, req.(*MFAReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_VerifyMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MFAReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).VerifyMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_VerifyMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).VerifyMFA(ctx, req.(*MFAReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_DisableMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MFAReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).DisableMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_DisableMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).DisableMFA(ctx, req.(*MFAReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_UnlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).UnlockUser(ctx, req.(*UnlockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_WhoAmI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).WhoAmI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_WhoAmI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).WhoAmI(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_CheckAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAccessReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).CheckAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_CheckAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).CheckAccess(ctx, req.(*CheckAccessReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Auth_ServiceDesc is the grpc.ServiceDesc for Auth service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +577,50 @@ var Auth_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Check",
 			Handler:    _Auth_Check_Handler,
 		},
+		{
+			MethodName: "ListClients",
+			Handler:    _Auth_ListClients_Handler,
+		},
+		{
+			MethodName: "GetClient",
+			Handler:    _Auth_GetClient_Handler,
+		},
+		{
+			MethodName: "CreateClient",
+			Handler:    _Auth_CreateClient_Handler,
+		},
+		{
+			MethodName: "UpdateClient",
+			Handler:    _Auth_UpdateClient_Handler,
+		},
+		{
+			MethodName: "DeleteClient",
+			Handler:    _Auth_DeleteClient_Handler,
+		},
+		{
+			MethodName: "EnrollMFA",
+			Handler:    _Auth_EnrollMFA_Handler,
+		},
+		{
+			MethodName: "VerifyMFA",
+			Handler:    _Auth_VerifyMFA_Handler,
+		},
+		{
+			MethodName: "DisableMFA",
+			Handler:    _Auth_DisableMFA_Handler,
+		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _Auth_UnlockUser_Handler,
+		},
+		{
+			MethodName: "WhoAmI",
+			Handler:    _Auth_WhoAmI_Handler,
+		},
+		{
+			MethodName: "CheckAccess",
+			Handler:    _Auth_CheckAccess_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
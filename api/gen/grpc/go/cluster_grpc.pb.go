@@ -28,6 +28,7 @@ const (
 	Cluster_CreateUser_FullMethodName   = "/ceph.Cluster/CreateUser"
 	Cluster_ExportUser_FullMethodName   = "/ceph.Cluster/ExportUser"
 	Cluster_DeleteUser_FullMethodName   = "/ceph.Cluster/DeleteUser"
+	Cluster_ListClusters_FullMethodName = "/ceph.Cluster/ListClusters"
 )
 
 // ClusterClient is the client API for Cluster service.
@@ -43,6 +44,8 @@ type ClusterClient interface {
 	CreateUser(ctx context.Context, in *CreateClusterUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ExportUser(ctx context.Context, in *ExportClusterUserReq, opts ...grpc.CallOption) (*ExportClusterUserResp, error)
 	DeleteUser(ctx context.Context, in *DeleteClusterUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List Ceph clusters managed by API with their fsid and health
+	ListClusters(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClustersList, error)
 }
 
 type clusterClient struct {
@@ -123,6 +126,16 @@ func (c *clusterClient) DeleteUser(ctx context.Context, in *DeleteClusterUserReq
 	return out, nil
 }
 
+func (c *clusterClient) ListClusters(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClustersList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClustersList)
+	err := c.cc.Invoke(ctx, Cluster_ListClusters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServer is the server API for Cluster service.
 // All implementations should embed UnimplementedClusterServer
 // for forward compatibility.
@@ -136,6 +149,8 @@ type ClusterServer interface {
 	CreateUser(context.Context, *CreateClusterUserReq) (*emptypb.Empty, error)
 	ExportUser(context.Context, *ExportClusterUserReq) (*ExportClusterUserResp, error)
 	DeleteUser(context.Context, *DeleteClusterUserReq) (*emptypb.Empty, error)
+	// List Ceph clusters managed by API with their fsid and health
+	ListClusters(context.Context, *emptypb.Empty) (*ClustersList, error)
 }
 
 // UnimplementedClusterServer should be embedded to have
@@ -166,6 +181,9 @@ func (UnimplementedClusterServer) ExportUser(context.Context, *ExportClusterUser
 func (UnimplementedClusterServer) DeleteUser(context.Context, *DeleteClusterUserReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedClusterServer) ListClusters(context.Context, *emptypb.Empty) (*ClustersList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusters not implemented")
+}
 func (UnimplementedClusterServer) testEmbeddedByValue() {}
 
 // UnsafeClusterServer may be embedded to opt out of forward compatibility for this service.
@@ -312,6 +330,24 @@ func _Cluster_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cluster_ListClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).ListClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_ListClusters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).ListClusters(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Cluster_ServiceDesc is the grpc.ServiceDesc for Cluster service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -347,6 +383,10 @@ var Cluster_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _Cluster_DeleteUser_Handler,
 		},
+		{
+			MethodName: "ListClusters",
+			Handler:    _Cluster_ListClusters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cluster.proto",
@@ -21,18 +21,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Users_ListUsers_FullMethodName          = "/ceph.Users/ListUsers"
-	Users_GetUser_FullMethodName            = "/ceph.Users/GetUser"
-	Users_CreateUser_FullMethodName         = "/ceph.Users/CreateUser"
-	Users_DeleteUser_FullMethodName         = "/ceph.Users/DeleteUser"
-	Users_UpdateUser_FullMethodName         = "/ceph.Users/UpdateUser"
-	Users_UserChangePassword_FullMethodName = "/ceph.Users/UserChangePassword"
-	Users_ListRoles_FullMethodName          = "/ceph.Users/ListRoles"
-	Users_GetRole_FullMethodName            = "/ceph.Users/GetRole"
-	Users_CreateRole_FullMethodName         = "/ceph.Users/CreateRole"
-	Users_DeleteRole_FullMethodName         = "/ceph.Users/DeleteRole"
-	Users_UpdateRole_FullMethodName         = "/ceph.Users/UpdateRole"
-	Users_CloneRole_FullMethodName          = "/ceph.Users/CloneRole"
+	Users_ListUsers_FullMethodName                        = "/ceph.Users/ListUsers"
+	Users_GetUser_FullMethodName                          = "/ceph.Users/GetUser"
+	Users_CreateUser_FullMethodName                       = "/ceph.Users/CreateUser"
+	Users_DeleteUser_FullMethodName                       = "/ceph.Users/DeleteUser"
+	Users_UpdateUser_FullMethodName                       = "/ceph.Users/UpdateUser"
+	Users_UserChangePassword_FullMethodName               = "/ceph.Users/UserChangePassword"
+	Users_ValidatePassword_FullMethodName                 = "/ceph.Users/ValidatePassword"
+	Users_ListRoles_FullMethodName                        = "/ceph.Users/ListRoles"
+	Users_GetRole_FullMethodName                          = "/ceph.Users/GetRole"
+	Users_CreateRole_FullMethodName                       = "/ceph.Users/CreateRole"
+	Users_DeleteRole_FullMethodName                       = "/ceph.Users/DeleteRole"
+	Users_UpdateRole_FullMethodName                       = "/ceph.Users/UpdateRole"
+	Users_CloneRole_FullMethodName                        = "/ceph.Users/CloneRole"
+	Users_CreateServiceAccount_FullMethodName             = "/ceph.Users/CreateServiceAccount"
+	Users_CreateAPIKey_FullMethodName                     = "/ceph.Users/CreateAPIKey"
+	Users_ListAPIKeys_FullMethodName                      = "/ceph.Users/ListAPIKeys"
+	Users_RevokeAPIKey_FullMethodName                     = "/ceph.Users/RevokeAPIKey"
+	Users_ResetMFA_FullMethodName                         = "/ceph.Users/ResetMFA"
+	Users_ExportAccessDB_FullMethodName                   = "/ceph.Users/ExportAccessDB"
+	Users_ExportAccessDBWithoutCredentials_FullMethodName = "/ceph.Users/ExportAccessDBWithoutCredentials"
+	Users_PreviewImportAccessDB_FullMethodName            = "/ceph.Users/PreviewImportAccessDB"
+	Users_ImportAccessDB_FullMethodName                   = "/ceph.Users/ImportAccessDB"
 )
 
 // UsersClient is the client API for Users service.
@@ -45,12 +55,30 @@ type UsersClient interface {
 	DeleteUser(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateUser(ctx context.Context, in *CreateUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UserChangePassword(ctx context.Context, in *UserChangePasswordReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ValidatePassword checks candidate password against password policy. Does not require authentication.
+	ValidatePassword(ctx context.Context, in *ValidatePasswordReq, opts ...grpc.CallOption) (*ValidatePasswordResp, error)
 	ListRoles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RolesResp, error)
 	GetRole(ctx context.Context, in *GetRoleReq, opts ...grpc.CallOption) (*Role, error)
 	CreateRole(ctx context.Context, in *Role, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteRole(ctx context.Context, in *GetRoleReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateRole(ctx context.Context, in *Role, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CloneRole(ctx context.Context, in *CloneRoleReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyReq, opts ...grpc.CallOption) (*APIKeyCreated, error)
+	ListAPIKeys(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*APIKeysResp, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ResetMFA disables MFA of user who lost authenticator app and recovery codes
+	ResetMFA(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ExportAccessDB returns all users and roles as signed JSON document.
+	// Document contains password hashes, API key hashes and MFA secrets, so it requires the same permissions as restore.
+	ExportAccessDB(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AccessDBBackup, error)
+	// ExportAccessDBWithoutCredentials returns users and roles backup without password hashes, API keys and MFA.
+	// Restored users keep their current credentials, users missing in access DB cannot be restored from it.
+	ExportAccessDBWithoutCredentials(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AccessDBBackup, error)
+	// PreviewImportAccessDB validates backup and returns changes which ImportAccessDB would make.
+	PreviewImportAccessDB(ctx context.Context, in *ImportAccessDBReq, opts ...grpc.CallOption) (*AccessDBChanges, error)
+	// ImportAccessDB restores users and roles from backup.
+	ImportAccessDB(ctx context.Context, in *ImportAccessDBReq, opts ...grpc.CallOption) (*AccessDBChanges, error)
 }
 
 type usersClient struct {
@@ -121,6 +149,16 @@ func (c *usersClient) UserChangePassword(ctx context.Context, in *UserChangePass
 	return out, nil
 }
 
+func (c *usersClient) ValidatePassword(ctx context.Context, in *ValidatePasswordReq, opts ...grpc.CallOption) (*ValidatePasswordResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatePasswordResp)
+	err := c.cc.Invoke(ctx, Users_ValidatePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersClient) ListRoles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RolesResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RolesResp)
@@ -181,6 +219,96 @@ func (c *usersClient) CloneRole(ctx context.Context, in *CloneRoleReq, opts ...g
 	return out, nil
 }
 
+func (c *usersClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Users_CreateServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyReq, opts ...grpc.CallOption) (*APIKeyCreated, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKeyCreated)
+	err := c.cc.Invoke(ctx, Users_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ListAPIKeys(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*APIKeysResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKeysResp)
+	err := c.cc.Invoke(ctx, Users_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Users_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ResetMFA(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Users_ResetMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ExportAccessDB(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AccessDBBackup, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessDBBackup)
+	err := c.cc.Invoke(ctx, Users_ExportAccessDB_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ExportAccessDBWithoutCredentials(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AccessDBBackup, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessDBBackup)
+	err := c.cc.Invoke(ctx, Users_ExportAccessDBWithoutCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) PreviewImportAccessDB(ctx context.Context, in *ImportAccessDBReq, opts ...grpc.CallOption) (*AccessDBChanges, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessDBChanges)
+	err := c.cc.Invoke(ctx, Users_PreviewImportAccessDB_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ImportAccessDB(ctx context.Context, in *ImportAccessDBReq, opts ...grpc.CallOption) (*AccessDBChanges, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessDBChanges)
+	err := c.cc.Invoke(ctx, Users_ImportAccessDB_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsersServer is the server API for Users service.
 // All implementations should embed UnimplementedUsersServer
 // for forward compatibility.
@@ -191,12 +319,30 @@ type UsersServer interface {
 	DeleteUser(context.Context, *GetUserReq) (*emptypb.Empty, error)
 	UpdateUser(context.Context, *CreateUserReq) (*emptypb.Empty, error)
 	UserChangePassword(context.Context, *UserChangePasswordReq) (*emptypb.Empty, error)
+	// ValidatePassword checks candidate password against password policy. Does not require authentication.
+	ValidatePassword(context.Context, *ValidatePasswordReq) (*ValidatePasswordResp, error)
 	ListRoles(context.Context, *emptypb.Empty) (*RolesResp, error)
 	GetRole(context.Context, *GetRoleReq) (*Role, error)
 	CreateRole(context.Context, *Role) (*emptypb.Empty, error)
 	DeleteRole(context.Context, *GetRoleReq) (*emptypb.Empty, error)
 	UpdateRole(context.Context, *Role) (*emptypb.Empty, error)
 	CloneRole(context.Context, *CloneRoleReq) (*emptypb.Empty, error)
+	CreateServiceAccount(context.Context, *CreateServiceAccountReq) (*emptypb.Empty, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyReq) (*APIKeyCreated, error)
+	ListAPIKeys(context.Context, *GetUserReq) (*APIKeysResp, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyReq) (*emptypb.Empty, error)
+	// ResetMFA disables MFA of user who lost authenticator app and recovery codes
+	ResetMFA(context.Context, *GetUserReq) (*emptypb.Empty, error)
+	// ExportAccessDB returns all users and roles as signed JSON document.
+	// Document contains password hashes, API key hashes and MFA secrets, so it requires the same permissions as restore.
+	ExportAccessDB(context.Context, *emptypb.Empty) (*AccessDBBackup, error)
+	// ExportAccessDBWithoutCredentials returns users and roles backup without password hashes, API keys and MFA.
+	// Restored users keep their current credentials, users missing in access DB cannot be restored from it.
+	ExportAccessDBWithoutCredentials(context.Context, *emptypb.Empty) (*AccessDBBackup, error)
+	// PreviewImportAccessDB validates backup and returns changes which ImportAccessDB would make.
+	PreviewImportAccessDB(context.Context, *ImportAccessDBReq) (*AccessDBChanges, error)
+	// ImportAccessDB restores users and roles from backup.
+	ImportAccessDB(context.Context, *ImportAccessDBReq) (*AccessDBChanges, error)
 }
 
 // UnimplementedUsersServer should be embedded to have
@@ -224,6 +370,9 @@ func (UnimplementedUsersServer) UpdateUser(context.Context, *CreateUserReq) (*em
 func (UnimplementedUsersServer) UserChangePassword(context.Context, *UserChangePasswordReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserChangePassword not implemented")
 }
+func (UnimplementedUsersServer) ValidatePassword(context.Context, *ValidatePasswordReq) (*ValidatePasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatePassword not implemented")
+}
 func (UnimplementedUsersServer) ListRoles(context.Context, *emptypb.Empty) (*RolesResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
 }
@@ -242,6 +391,33 @@ func (UnimplementedUsersServer) UpdateRole(context.Context, *Role) (*emptypb.Emp
 func (UnimplementedUsersServer) CloneRole(context.Context, *CloneRoleReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloneRole not implemented")
 }
+func (UnimplementedUsersServer) CreateServiceAccount(context.Context, *CreateServiceAccountReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedUsersServer) CreateAPIKey(context.Context, *CreateAPIKeyReq) (*APIKeyCreated, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedUsersServer) ListAPIKeys(context.Context, *GetUserReq) (*APIKeysResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedUsersServer) RevokeAPIKey(context.Context, *RevokeAPIKeyReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedUsersServer) ResetMFA(context.Context, *GetUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetMFA not implemented")
+}
+func (UnimplementedUsersServer) ExportAccessDB(context.Context, *emptypb.Empty) (*AccessDBBackup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAccessDB not implemented")
+}
+func (UnimplementedUsersServer) ExportAccessDBWithoutCredentials(context.Context, *emptypb.Empty) (*AccessDBBackup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAccessDBWithoutCredentials not implemented")
+}
+func (UnimplementedUsersServer) PreviewImportAccessDB(context.Context, *ImportAccessDBReq) (*AccessDBChanges, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewImportAccessDB not implemented")
+}
+func (UnimplementedUsersServer) ImportAccessDB(context.Context, *ImportAccessDBReq) (*AccessDBChanges, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAccessDB not implemented")
+}
 func (UnimplementedUsersServer) testEmbeddedByValue() {}
 
 // UnsafeUsersServer may be embedded to opt out of forward compatibility for this service.
@@ -370,6 +546,24 @@ func _Users_UserChangePassword_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Users_ValidatePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatePasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ValidatePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_ValidatePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ValidatePassword(ctx, req.(*ValidatePasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Users_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -478,6 +672,168 @@ func _Users_CloneRole_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Users_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_CreateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).CreateAPIKey(ctx, req.(*CreateAPIKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ListAPIKeys(ctx, req.(*GetUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ResetMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ResetMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_ResetMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ResetMFA(ctx, req.(*GetUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ExportAccessDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ExportAccessDB(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_ExportAccessDB_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ExportAccessDB(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ExportAccessDBWithoutCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ExportAccessDBWithoutCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_ExportAccessDBWithoutCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ExportAccessDBWithoutCredentials(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_PreviewImportAccessDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAccessDBReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).PreviewImportAccessDB(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_PreviewImportAccessDB_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).PreviewImportAccessDB(ctx, req.(*ImportAccessDBReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ImportAccessDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAccessDBReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ImportAccessDB(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_ImportAccessDB_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ImportAccessDB(ctx, req.(*ImportAccessDBReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Users_ServiceDesc is the grpc.ServiceDesc for Users service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -509,6 +865,10 @@ var Users_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UserChangePassword",
 			Handler:    _Users_UserChangePassword_Handler,
 		},
+		{
+			MethodName: "ValidatePassword",
+			Handler:    _Users_ValidatePassword_Handler,
+		},
 		{
 			MethodName: "ListRoles",
 			Handler:    _Users_ListRoles_Handler,
@@ -533,6 +893,42 @@ var Users_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloneRole",
 			Handler:    _Users_CloneRole_Handler,
 		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _Users_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _Users_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _Users_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _Users_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "ResetMFA",
+			Handler:    _Users_ResetMFA_Handler,
+		},
+		{
+			MethodName: "ExportAccessDB",
+			Handler:    _Users_ExportAccessDB_Handler,
+		},
+		{
+			MethodName: "ExportAccessDBWithoutCredentials",
+			Handler:    _Users_ExportAccessDBWithoutCredentials_Handler,
+		},
+		{
+			MethodName: "PreviewImportAccessDB",
+			Handler:    _Users_PreviewImportAccessDB_Handler,
+		},
+		{
+			MethodName: "ImportAccessDB",
+			Handler:    _Users_ImportAccessDB_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "users.proto",
@@ -63,6 +63,11 @@ func (c *Client) Close() error {
 	return err
 }
 
+// WithCluster returns context to call gRPC API of given Ceph cluster. Default cluster is used if not set.
+func WithCluster(ctx context.Context, cluster string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "ceph-cluster", cluster)
+}
+
 // New returns authenticated vault client. Revokes all tokens on ctx cancel or on Client.Close() method.
 func New(ctx context.Context, conf ClientConfig) (*Client, error) {
 	c := &Client{
@@ -119,20 +124,14 @@ func New(ctx context.Context, conf ClientConfig) (*Client, error) {
 				if err != nil {
 					return err
 				}
-				md := metadata.Pairs(
-					"Authorization", "Bearer "+t.AccessToken,
-				)
-				return invoker(metadata.NewOutgoingContext(ctx, md), method, req, reply, cc, opts...)
+				return invoker(metadata.AppendToOutgoingContext(ctx, "Authorization", "Bearer "+t.AccessToken), method, req, reply, cc, opts...)
 			}),
 			grpc.WithChainStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
 				t, err := ac.PasswordCredentialsToken(oauthCtx, conf.Login, conf.Password)
 				if err != nil {
 					return nil, err
 				}
-				md := metadata.Pairs(
-					"Authorization", "Bearer "+t.AccessToken,
-				)
-				return streamer(metadata.NewOutgoingContext(ctx, md), desc, cc, method, opts...)
+				return streamer(metadata.AppendToOutgoingContext(ctx, "Authorization", "Bearer "+t.AccessToken), desc, cc, method, opts...)
 			}),
 		)
 	} else {
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/audit"
+	"github.com/clyso/ceph-api/pkg/types"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	auditDefaultLimit = 1000
+	auditMaxLimit     = 10000
+)
+
+func NewAuditAPI(auditLog *audit.Log) pb.AuditServer {
+	return &auditAPI{
+		auditLog: auditLog,
+	}
+}
+
+type auditAPI struct {
+	auditLog *audit.Log
+}
+
+func (a *auditAPI) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsReq) (*pb.AuditEvents, error) {
+	if req.Days < 0 || req.Limit < 0 || req.Limit > auditMaxLimit {
+		return nil, fmt.Errorf("%w: days must be non-negative and limit must be in range [0, %d]", types.ErrInvalidArg, auditMaxLimit)
+	}
+	opts := audit.ListOpts{
+		Since:    time.Now().AddDate(0, 0, -int(max(req.Days, 1))),
+		Username: req.GetUsername(),
+		Method:   req.GetMethod(),
+		Event:    req.GetEvent(),
+		Limit:    int(req.Limit),
+	}
+	if opts.Limit == 0 {
+		opts.Limit = auditDefaultLimit
+	}
+	events, intact, err := a.auditLog.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.AuditEvents{ChainIntact: intact, Events: make([]*pb.AuditEvent, len(events))}
+	for i, e := range events {
+		res.Events[i] = &pb.AuditEvent{
+			Seq:       e.Seq,
+			Time:      timestamppb.New(e.Time),
+			Event:     e.Event,
+			Username:  e.Username,
+			SourceIp:  e.SourceIP,
+			Method:    e.Method,
+			Resources: e.Resources,
+			Code:      e.Code,
+			Reason:    e.Reason,
+			TraceId:   e.TraceID,
+			PrevHash:  e.PrevHash,
+			Hash:      e.Hash,
+		}
+		if len(e.Request) != 0 {
+			res.Events[i].Request = &structpb.Struct{}
+			// request is written by API as JSON object
+			_ = res.Events[i].Request.UnmarshalJSON(e.Request)
+		}
+	}
+	return res, nil
+}
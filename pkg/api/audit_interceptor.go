@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+
+	"github.com/clyso/ceph-api/pkg/audit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// unaryServerAudit writes audit event for mutating methods and methods declared with audit option.
+// Methods without declared rbac rule are audited too, they are rejected by rbac interceptor.
+func unaryServerAudit(auditLog *audit.Log) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		rule, ok := findRbacRule(info.FullMethod)
+		if auditLog == nil || (ok && !rule.audit) {
+			return handler(ctx, req)
+		}
+		resp, err := handler(ctx, req)
+		s := status.Convert(err)
+		event := audit.Event{
+			Event:     audit.EventRPC,
+			Method:    info.FullMethod,
+			Resources: audit.RequestResources(req),
+			Request:   audit.RedactRequest(req),
+			Code:      s.Code().String(),
+		}
+		if err != nil {
+			event.Reason = s.Message()
+		}
+		auditLog.Record(ctx, event)
+		return resp, err
+	}
+}
+
+func streamServerAudit(auditLog *audit.Log) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		rule, ok := findRbacRule(info.FullMethod)
+		if auditLog == nil || (ok && !rule.audit) {
+			return handler(srv, stream)
+		}
+		err := handler(srv, stream)
+		s := status.Convert(err)
+		event := audit.Event{Event: audit.EventRPC, Method: info.FullMethod, Code: s.Code().String()}
+		if err != nil {
+			event.Reason = s.Message()
+		}
+		auditLog.Record(stream.Context(), event)
+		return err
+	}
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/audit"
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_unaryServerAudit(t *testing.T) {
+	r := require.New(t)
+	dir := t.TempDir()
+	r.NoError(os.WriteFile(filepath.Join(dir, "audit.key"), bytes.Repeat([]byte("k"), 32), 0o600))
+	auditLog, err := audit.New(context.Background(), audit.Config{
+		Enabled: true,
+		KeyFile: filepath.Join(dir, "audit.key"),
+		Sink:    audit.SinkFile,
+		File:    audit.FileConfig{Path: filepath.Join(dir, "audit.log"), MaxSizeMB: 1},
+	}, nil)
+	r.NoError(err)
+	defer auditLog.Close()
+	interceptor := unaryServerAudit(auditLog)
+	ctx := xctx.SetUsername(context.Background(), "admin")
+	call := func(method string, req any, err error) {
+		_, _ = interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) {
+			return nil, err
+		})
+	}
+
+	call("/ceph.Users/ListUsers", nil, nil)
+	call("/ceph.Users/CreateUser", &pb.CreateUserReq{Username: "bob", Password: "secret-pass"}, nil)
+	call("/ceph.Users/ExportAccessDB", nil, nil)
+	call("/ceph.Users/DeleteUser", &pb.GetUserReq{Username: "bob"}, status.Error(codes.PermissionDenied, "AccessDenied"))
+	call("/ceph.Unknown/Method", nil, status.Error(codes.PermissionDenied, "no access requirement"))
+
+	events, intact, err := auditLog.List(ctx, audit.ListOpts{})
+	r.NoError(err)
+	r.True(intact)
+	r.Len(events, 4, "read methods are audited only with audit option")
+	r.Equal("/ceph.Users/CreateUser", events[0].Method)
+	r.Equal("admin", events[0].Username)
+	r.Equal(codes.OK.String(), events[0].Code)
+	r.Equal(map[string]string{"username": "bob"}, events[0].Resources)
+	r.NotContains(string(events[0].Request), "secret-pass")
+	r.Equal("/ceph.Users/ExportAccessDB", events[1].Method)
+	r.Equal(codes.PermissionDenied.String(), events[2].Code)
+	r.Equal("AccessDenied", events[2].Reason)
+	r.Equal("/ceph.Unknown/Method", events[3].Method)
+}
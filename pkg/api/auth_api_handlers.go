@@ -2,12 +2,16 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
 	"github.com/clyso/ceph-api/pkg/auth"
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
 	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
 	"google.golang.org/protobuf/types/known/emptypb"
-	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func NewAuthAPI(svc *auth.Server) pb.AuthServer {
@@ -25,24 +29,17 @@ func (a *authAPI) Check(ctx context.Context, req *pb.TokenCheckReq) (*pb.TokenCh
 }
 
 func (a *authAPI) Login(ctx context.Context, req *pb.LoginReq) (*pb.LoginResp, error) {
-	res, err := a.svc.Login(ctx, req.Username, req.Password)
+	res, err := a.svc.Login(ctx, req.Username, req.Password, req.Otp)
 	if err != nil {
 		return nil, err
 	}
-	permissions := make(map[string]*structpb.ListValue, len(res.Permissions))
-	for p, vals := range res.Permissions {
-		permissions[p] = &structpb.ListValue{}
-		for _, v := range vals {
-			permissions[p].Values = append(permissions[p].Values, structpb.NewStringValue(v))
-		}
-	}
 	return &pb.LoginResp{
 		Token:             res.Token,
 		Username:          res.User.Username,
-		PwdUpdateRequired: res.User.PwdUpdateRequired,
+		PwdUpdateRequired: res.User.PasswordChangeRequired(time.Now()),
 		PwdExpirationDate: tsToPb(res.User.PwdExpirationDate),
 		Sso:               false,
-		Permissions:       permissions,
+		Permissions:       permissionsToPb(res.Permissions),
 	}, nil
 }
 
@@ -53,3 +50,135 @@ func (a *authAPI) Logout(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty,
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (a *authAPI) ListClients(ctx context.Context, _ *emptypb.Empty) (*pb.OAuthClientsResp, error) {
+	clients, err := a.svc.ListClients(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*pb.OAuthClient, len(clients))
+	for i, c := range clients {
+		res[i] = oauthClientToPb(c)
+	}
+	return &pb.OAuthClientsResp{Clients: res}, nil
+}
+
+func (a *authAPI) GetClient(ctx context.Context, req *pb.GetOAuthClientReq) (*pb.OAuthClient, error) {
+	client, err := a.svc.GetClient(ctx, req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	return oauthClientToPb(client), nil
+}
+
+func (a *authAPI) CreateClient(ctx context.Context, req *pb.OAuthClient) (*pb.OAuthClientSecret, error) {
+	secret, err := a.svc.CreateClient(ctx, oauthClientFromPb(req))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.OAuthClientSecret{ClientId: req.ClientId, ClientSecret: secret}, nil
+}
+
+func (a *authAPI) UpdateClient(ctx context.Context, req *pb.UpdateOAuthClientReq) (*pb.OAuthClientSecret, error) {
+	if req.Client == nil {
+		return nil, fmt.Errorf("%w: client required", types.ErrInvalidArg)
+	}
+	secret, err := a.svc.UpdateClient(ctx, oauthClientFromPb(req.Client), req.RegenerateSecret)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.OAuthClientSecret{ClientId: req.Client.ClientId, ClientSecret: secret}, nil
+}
+
+func (a *authAPI) DeleteClient(ctx context.Context, req *pb.GetOAuthClientReq) (*emptypb.Empty, error) {
+	err := a.svc.DeleteClient(ctx, req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func oauthClientToPb(c auth.OAuthClient) *pb.OAuthClient {
+	res := &pb.OAuthClient{
+		ClientId:     c.ID,
+		Description:  c.Description,
+		Public:       c.Public,
+		GrantTypes:   c.GrantTypes,
+		Scopes:       c.Scopes,
+		RedirectUris: c.RedirectURIs,
+		Roles:        c.Roles,
+		Builtin:      c.Builtin,
+	}
+	if c.CreatedAt != 0 {
+		res.CreatedAt = &timestamppb.Timestamp{Seconds: c.CreatedAt}
+	}
+	return res
+}
+
+func oauthClientFromPb(c *pb.OAuthClient) auth.OAuthClient {
+	return auth.OAuthClient{
+		ID:           c.ClientId,
+		Description:  c.Description,
+		Public:       c.Public,
+		GrantTypes:   c.GrantTypes,
+		Scopes:       c.Scopes,
+		RedirectURIs: c.RedirectUris,
+		Roles:        c.Roles,
+	}
+}
+
+func (a *authAPI) EnrollMFA(ctx context.Context, req *pb.MFAReq) (*pb.MFAEnrollment, error) {
+	res, err := a.svc.EnrollMFA(ctx, req.Username, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MFAEnrollment{Secret: res.Secret, Uri: res.URI}, nil
+}
+
+func (a *authAPI) VerifyMFA(ctx context.Context, req *pb.MFAReq) (*pb.MFARecoveryCodes, error) {
+	codes, err := a.svc.VerifyMFA(ctx, req.Username, req.Password, req.Otp)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MFARecoveryCodes{RecoveryCodes: codes}, nil
+}
+
+func (a *authAPI) DisableMFA(ctx context.Context, req *pb.MFAReq) (*emptypb.Empty, error) {
+	err := a.svc.DisableMFA(ctx, req.Username, req.Password, req.Otp)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (a *authAPI) UnlockUser(ctx context.Context, req *pb.UnlockUserReq) (*emptypb.Empty, error) {
+	err := a.svc.UnlockUser(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (a *authAPI) WhoAmI(ctx context.Context, _ *emptypb.Empty) (*pb.WhoAmIResp, error) {
+	res := &pb.WhoAmIResp{
+		Username:    xctx.GetUsername(ctx),
+		Roles:       xctx.GetRoles(ctx),
+		Permissions: permissionsToPb(xctx.GetPermissions(ctx)),
+	}
+	if exp := xctx.GetExpiresAt(ctx); !exp.IsZero() {
+		res.ExpiresAt = timestamppb.New(exp)
+	}
+	return res, nil
+}
+
+func (a *authAPI) CheckAccess(ctx context.Context, req *pb.CheckAccessReq) (*pb.CheckAccessResp, error) {
+	res := &pb.CheckAccessResp{Results: make([]*pb.AccessCheckResult, len(req.Checks))}
+	for i, check := range req.Checks {
+		allowed, err := user.CheckAccess(ctx, check.Scope, check.Permission, check.Resource)
+		if err != nil {
+			return nil, err
+		}
+		res.Results[i] = &pb.AccessCheckResult{Check: check, Allowed: allowed}
+	}
+	return res, nil
+}
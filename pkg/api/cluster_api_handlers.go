@@ -5,12 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
-	"strconv"
-	"strings"
+	"sort"
+	"sync"
 
 	gorados "github.com/ceph/go-ceph/rados"
 	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
 	"github.com/clyso/ceph-api/pkg/rados"
 	"github.com/clyso/ceph-api/pkg/types"
 	"github.com/clyso/ceph-api/pkg/user"
@@ -18,23 +18,22 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-func NewClusterAPI(radosSvc *rados.Svc) pb.ClusterServer {
+func NewClusterAPI(radosSvc *rados.Clusters) pb.ClusterServer {
 	return &clusterAPI{
 		radosSvc: radosSvc,
 	}
 }
 
 type clusterAPI struct {
-	radosSvc *rados.Svc
+	radosSvc *rados.Clusters
 }
 
 func (c *clusterAPI) DeleteUser(ctx context.Context, req *pb.DeleteClusterUserReq) (*emptypb.Empty, error) {
-	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermDelete); err != nil {
+	monCmd, err := rados.MonCmd{Prefix: "auth del", Args: map[string]any{"entity": req.UserEntity}}.Build()
+	if err != nil {
 		return nil, err
 	}
-	const monCmdTeml = `{"prefix": "auth del", "entity": "%s"}`
-	monCmd := fmt.Sprintf(monCmdTeml, req.UserEntity)
-	_, err := c.radosSvc.ExecMon(ctx, monCmd)
+	_, err = c.radosSvc.ExecMon(ctx, monCmd)
 	if err != nil {
 		return nil, err
 	}
@@ -42,13 +41,12 @@ func (c *clusterAPI) DeleteUser(ctx context.Context, req *pb.DeleteClusterUserRe
 }
 
 func (c *clusterAPI) ExportUser(ctx context.Context, req *pb.ExportClusterUserReq) (*pb.ExportClusterUserResp, error) {
-	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermRead); err != nil {
-		return nil, err
-	}
 	buf := bytes.NewBuffer(nil)
 	for _, entity := range req.Entities {
-		const monCmdTeml = `{"prefix": "auth export", "entity": "%s"}`
-		monCmd := fmt.Sprintf(monCmdTeml, entity)
+		monCmd, err := rados.MonCmd{Prefix: "auth export", Args: map[string]any{"entity": entity}}.Build()
+		if err != nil {
+			return nil, err
+		}
 		res, err := c.radosSvc.ExecMon(ctx, monCmd)
 		if err != nil {
 			zerolog.Ctx(ctx).Err(err).Str("mon_cmd", monCmd).Msg("unable to export user")
@@ -61,10 +59,11 @@ func (c *clusterAPI) ExportUser(ctx context.Context, req *pb.ExportClusterUserRe
 }
 
 func (c *clusterAPI) CreateUser(ctx context.Context, req *pb.CreateClusterUserReq) (*emptypb.Empty, error) {
-	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermCreate); err != nil {
-		return nil, err
-	}
 	if len(req.ImportData) != 0 {
+		// imported keyring can contain any entities
+		if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermCreate); err != nil {
+			return nil, err
+		}
 		zerolog.Ctx(ctx).Debug().Msg("import user data")
 		const monCmd = `{"prefix": "auth import"}`
 		_, err := c.radosSvc.ExecMonWithInputBuff(ctx, monCmd, req.ImportData)
@@ -74,13 +73,14 @@ func (c *clusterAPI) CreateUser(ctx context.Context, req *pb.CreateClusterUserRe
 		return &emptypb.Empty{}, nil
 	}
 
-	const cmdTempl = `{"prefix": "auth add", "entity": "%s", "caps": [%s]}`
-	caps := make([]string, 0, len(req.Capabilities)*2)
-	for k, v := range req.Capabilities {
-		caps = append(caps, strconv.Quote(k), strconv.Quote(v))
+	monCmd, err := rados.MonCmd{
+		Prefix: "auth add",
+		Args:   map[string]any{"entity": req.UserEntity, "caps": capsArg(req.Capabilities)},
+	}.Build()
+	if err != nil {
+		return nil, err
 	}
-	monCmd := fmt.Sprintf(cmdTempl, req.UserEntity, strings.Join(caps, ","))
-	_, err := c.radosSvc.ExecMon(ctx, monCmd)
+	_, err = c.radosSvc.ExecMon(ctx, monCmd)
 	if err != nil {
 		return nil, err
 	}
@@ -89,9 +89,6 @@ func (c *clusterAPI) CreateUser(ctx context.Context, req *pb.CreateClusterUserRe
 
 // GetUsers implements pb.ClusterServer.
 func (c *clusterAPI) GetUsers(ctx context.Context, _ *emptypb.Empty) (*pb.ClusterUsers, error) {
-	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermRead); err != nil {
-		return nil, err
-	}
 	const monCmd = `{"prefix": "auth ls", "format": "json"}`
 
 	cmdRes, err := c.radosSvc.ExecMon(ctx, monCmd)
@@ -106,20 +103,25 @@ func (c *clusterAPI) GetUsers(ctx context.Context, _ *emptypb.Empty) (*pb.Cluste
 	if err != nil {
 		return nil, err
 	}
-	return &pb.ClusterUsers{Users: res.AuthDump}, nil
+	// return only users which request user can read
+	users := res.AuthDump[:0]
+	for _, usr := range res.AuthDump {
+		if user.HasResourcePermissions(ctx, user.ScopeConfigOpt, usr.Entity, user.PermRead) == nil {
+			users = append(users, usr)
+		}
+	}
+	return &pb.ClusterUsers{Users: users}, nil
 }
 
 func (c *clusterAPI) UpdateUser(ctx context.Context, req *pb.UpdateClusterUserReq) (*emptypb.Empty, error) {
-	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermUpdate); err != nil {
+	monCmd, err := rados.MonCmd{
+		Prefix: "auth caps",
+		Args:   map[string]any{"entity": req.UserEntity, "caps": capsArg(req.Capabilities)},
+	}.Build()
+	if err != nil {
 		return nil, err
 	}
-	const cmdTempl = `{"prefix": "auth caps", "entity": "%s", "caps": [%s]}`
-	caps := make([]string, 0, len(req.Capabilities)*2)
-	for k, v := range req.Capabilities {
-		caps = append(caps, strconv.Quote(k), strconv.Quote(v))
-	}
-	monCmd := fmt.Sprintf(cmdTempl, req.UserEntity, strings.Join(caps, ","))
-	_, err := c.radosSvc.ExecMon(ctx, monCmd)
+	_, err = c.radosSvc.ExecMon(ctx, monCmd)
 	if err != nil {
 		if errors.Is(err, gorados.ErrNotFound) {
 			return nil, types.ErrNotFound
@@ -129,10 +131,21 @@ func (c *clusterAPI) UpdateUser(ctx context.Context, req *pb.UpdateClusterUserRe
 	return &emptypb.Empty{}, nil
 }
 
-func (c *clusterAPI) GetStatus(ctx context.Context, _ *emptypb.Empty) (*pb.ClusterStatus, error) {
-	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermRead); err != nil {
-		return nil, err
+// capsArg returns capabilities as "caps" command argument: [<service>, <caps>, ...] sorted by service.
+func capsArg(caps map[string]string) []string {
+	services := make([]string, 0, len(caps))
+	for k := range caps {
+		services = append(services, k)
 	}
+	sort.Strings(services)
+	res := make([]string, 0, len(caps)*2)
+	for _, k := range services {
+		res = append(res, k, caps[k])
+	}
+	return res
+}
+
+func (c *clusterAPI) GetStatus(ctx context.Context, _ *emptypb.Empty) (*pb.ClusterStatus, error) {
 	const monCmd = `{"prefix":"config-key get", "key":"mgr/dashboard/cluster/status"}`
 	cmdRes, err := c.radosSvc.ExecMon(ctx, monCmd)
 	if err != nil {
@@ -148,17 +161,79 @@ func (c *clusterAPI) GetStatus(ctx context.Context, _ *emptypb.Empty) (*pb.Clust
 }
 
 func (c *clusterAPI) UpdateStatus(ctx context.Context, req *pb.ClusterStatus) (*emptypb.Empty, error) {
-	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermUpdate); err != nil {
+	monCmd, err := rados.MonCmd{
+		Prefix: "config-key set",
+		Args:   map[string]any{"key": "mgr/dashboard/cluster/status", "val": req.Status.String()},
+	}.Build()
+	if err != nil {
 		return nil, err
 	}
-	monCmd := fmt.Sprintf(
-		`{"prefix":"config-key set", "key":"mgr/dashboard/cluster/status", "val":"%s"}`,
-		req.Status.String())
 
-	_, err := c.radosSvc.ExecMon(ctx, monCmd)
+	_, err = c.radosSvc.ExecMon(ctx, monCmd)
 	if err != nil {
 		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+func (c *clusterAPI) ListClusters(ctx context.Context, _ *emptypb.Empty) (*pb.ClustersList, error) {
+	names := make([]string, 0, len(c.radosSvc.Names()))
+	for _, name := range c.radosSvc.Names() {
+		// show only clusters where user can read status
+		if user.HasPermissions(xctx.SetCluster(ctx, name), user.ScopeMonitor, user.PermRead) == nil {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, types.ErrAccessDenied
+	}
+	res := make([]*pb.ClusterInfo, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			res[i] = c.clusterInfo(ctx, name)
+		}(i, name)
+	}
+	wg.Wait()
+	return &pb.ClustersList{Clusters: res}, nil
+}
+
+func (c *clusterAPI) clusterInfo(ctx context.Context, name string) *pb.ClusterInfo {
+	res := &pb.ClusterInfo{Name: name, IsDefault: name == rados.DefaultCluster}
+	svc, err := c.radosSvc.Get(name)
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	res.Connected = svc.Ready()
+	cmdRes, err := svc.ExecMon(ctx, `{"prefix": "fsid", "format": "json"}`)
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	var fsid struct {
+		Fsid string `json:"fsid"`
+	}
+	if err = json.Unmarshal(cmdRes, &fsid); err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	res.Fsid = fsid.Fsid
+	cmdRes, err = svc.ExecMon(ctx, `{"prefix": "health", "format": "json"}`)
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	var health struct {
+		Status string `json:"status"`
+	}
+	if err = json.Unmarshal(cmdRes, &health); err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	res.Health = health.Status
+	return res
+}
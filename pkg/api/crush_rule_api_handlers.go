@@ -13,14 +13,14 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-func NewCrushRuleAPI(radosSvc *rados.Svc) pb.CrushRuleServer {
+func NewCrushRuleAPI(radosSvc *rados.Clusters) pb.CrushRuleServer {
 	return &crushRuleAPI{
 		radosSvc: radosSvc,
 	}
 }
 
 type crushRuleAPI struct {
-	radosSvc *rados.Svc
+	radosSvc *rados.Clusters
 }
 
 type crushDump struct {
@@ -28,48 +28,46 @@ type crushDump struct {
 }
 
 func (c *crushRuleAPI) CreateRule(ctx context.Context, req *pb.CreateRuleRequest) (*emptypb.Empty, error) {
-	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermCreate); err != nil {
-		return nil, err
-	}
-
-	// check if name and failure domain are set
 	if req.Name == "" {
 		return nil, fmt.Errorf("%w: name is required", types.ErrInvalidArg)
 	}
-	if req.FailureDomain == "" {
-		return nil, fmt.Errorf("%w: failure domain is required", types.ErrInvalidArg)
-	}
-	// Prepare the command map
-	cmdMap := map[string]interface{}{
-		"prefix": "osd crush rule create-replicated",
-		"name":   req.Name,
-		"type":   req.FailureDomain,
-		"format": "json",
-	}
-
-	// Add optional fields if present
-	if req.DeviceClass != nil {
-		cmdMap["class"] = *req.DeviceClass
+	cmd := rados.MonCmd{
+		Prefix: "osd crush rule create-replicated",
+		Args: map[string]any{
+			"name":   req.Name,
+			"format": "json",
+		},
 	}
 
 	// Adjust command and fields based on PoolType
 	if req.PoolType == pb.PoolType_erasure {
-		cmdMap["prefix"] = "osd crush rule create-erasure"
+		// failure domain, root and device class of erasure rule are defined by profile
+		if req.FailureDomain != "" || req.Root != nil || req.DeviceClass != nil {
+			return nil, fmt.Errorf("%w: failure domain, root and device class of erasure rule are set in erasure code profile", types.ErrInvalidArg)
+		}
+		cmd.Prefix = "osd crush rule create-erasure"
 		if req.Profile != nil {
-			cmdMap["profile"] = *req.Profile
+			cmd.Args["profile"] = *req.Profile
 		}
 	} else {
+		if req.FailureDomain == "" {
+			return nil, fmt.Errorf("%w: failure domain is required", types.ErrInvalidArg)
+		}
+		cmd.Args["type"] = req.FailureDomain
 		if req.Root != nil {
-			cmdMap["root"] = *req.Root
+			cmd.Args["root"] = *req.Root
+		}
+		if req.DeviceClass != nil {
+			cmd.Args["class"] = *req.DeviceClass
 		}
 	}
 
-	cmdBytes, err := json.Marshal(cmdMap)
+	monCmd, err := cmd.Build()
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = c.radosSvc.ExecMon(ctx, string(cmdBytes))
+	_, err = c.radosSvc.ExecMon(ctx, monCmd)
 	if err != nil {
 		return nil, err
 	}
@@ -78,22 +76,18 @@ func (c *crushRuleAPI) CreateRule(ctx context.Context, req *pb.CreateRuleRequest
 }
 
 func (c *crushRuleAPI) DeleteRule(ctx context.Context, req *pb.DeleteRuleRequest) (*emptypb.Empty, error) {
-	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermDelete); err != nil {
-		return nil, err
-	}
-
-	cmdMap := map[string]interface{}{
-		"prefix": "osd crush rule rm",
-		"name":   req.Name,
-		"format": "json",
-	}
-
-	cmdBytes, err := json.Marshal(cmdMap)
+	monCmd, err := rados.MonCmd{
+		Prefix: "osd crush rule rm",
+		Args: map[string]any{
+			"name":   req.Name,
+			"format": "json",
+		},
+	}.Build()
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = c.radosSvc.ExecMon(ctx, string(cmdBytes))
+	_, err = c.radosSvc.ExecMon(ctx, monCmd)
 	if err != nil {
 		return nil, err
 	}
@@ -102,9 +96,6 @@ func (c *crushRuleAPI) DeleteRule(ctx context.Context, req *pb.DeleteRuleRequest
 }
 
 func (c *crushRuleAPI) GetRule(ctx context.Context, req *pb.GetRuleRequest) (*pb.Rule, error) {
-	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermRead); err != nil {
-		return nil, err
-	}
 	const cmdTempl = `{"prefix": "osd crush dump", "format": "json"}`
 	res, err := c.radosSvc.ExecMon(ctx, cmdTempl)
 	if err != nil {
@@ -126,9 +117,6 @@ func (c *crushRuleAPI) GetRule(ctx context.Context, req *pb.GetRuleRequest) (*pb
 }
 
 func (c *crushRuleAPI) ListRules(ctx context.Context, req *emptypb.Empty) (*pb.ListRulesResponse, error) {
-	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermRead); err != nil {
-		return nil, err
-	}
 	const cmdTempl = `{"prefix": "osd crush dump", "format": "json"}`
 	res, err := c.radosSvc.ExecMon(ctx, cmdTempl)
 	if err != nil {
@@ -139,5 +127,12 @@ func (c *crushRuleAPI) ListRules(ctx context.Context, req *emptypb.Empty) (*pb.L
 		return nil, err
 	}
 
-	return &pb.ListRulesResponse{Rules: dump.Rules}, nil
+	// return only rules user can read
+	rules := dump.Rules[:0]
+	for _, rule := range dump.Rules {
+		if user.HasResourcePermissions(ctx, user.ScopeOsd, rule.RuleName, user.PermRead) == nil {
+			rules = append(rules, rule)
+		}
+	}
+	return &pb.ListRulesResponse{Rules: rules}, nil
 }
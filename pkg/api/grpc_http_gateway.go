@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"net/http/pprof"
 	"strconv"
+	"strings"
 
 	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/auth"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/tmc/grpc-websocket-proxy/wsproxy"
 	"google.golang.org/grpc"
@@ -16,8 +18,8 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-func GRPCGateway(ctx context.Context, conf Config, metricsHandler http.HandlerFunc, oauthHandlers map[string]http.HandlerFunc) (http.Handler, error) {
-	mux := runtime.NewServeMux()
+func GRPCGateway(ctx context.Context, conf Config, metricsHandler http.HandlerFunc, getHandlers, postHandlers map[string]http.HandlerFunc) (http.Handler, error) {
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher))
 	var opts []grpc.DialOption
 
 	if conf.Secure {
@@ -48,13 +50,20 @@ func GRPCGateway(ctx context.Context, conf Config, metricsHandler http.HandlerFu
 	if err != nil {
 		return nil, err
 	}
+	err = pb.RegisterAuditHandlerFromEndpoint(ctx, mux, serverAddress, opts)
+	if err != nil {
+		return nil, err
+	}
 
 	// Register metrics handler
 	if metricsHandler != nil {
 		handleGET(mux, "/metrics", metricsHandler)
 	}
-	// Register fosite oauth endpoints
-	for path, h := range oauthHandlers {
+	// Register health probes, oauth discovery and fosite oauth endpoints
+	for path, h := range getHandlers {
+		handleGET(mux, path, h)
+	}
+	for path, h := range postHandlers {
 		handlePOST(mux, path, h)
 	}
 
@@ -73,7 +82,7 @@ func GRPCGateway(ctx context.Context, conf Config, metricsHandler http.HandlerFu
 		handleGET(mux, "/debug/pprof/mutex", pprof.Handler("mutex").ServeHTTP)
 	}
 
-	handler := wsproxy.WebsocketProxy(mux)
+	handler := wsproxy.WebsocketProxy(clusterPathHandler(mux))
 	return handler, nil
 	// srv := &http.Server{Addr: fmt.Sprintf("0.0.0.0:%d", conf.HttpPort), ReadHeaderTimeout: time.Second * 5}
 	// srv.Handler = handler
@@ -87,6 +96,46 @@ func GRPCGateway(ctx context.Context, conf Config, metricsHandler http.HandlerFu
 	// return
 }
 
+// incomingHeaderMatcher forwards API key header in addition to default headers.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, auth.APIKeyMetadataKey) {
+		return auth.APIKeyMetadataKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// clusterPathHandler serves "/api/clusters/{cluster}/<path>" requests as "/api/<path>" for selected cluster.
+func clusterPathHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rest, ok := strings.CutPrefix(r.URL.Path, "/api/clusters/")
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		cluster, path, ok := strings.Cut(rest, "/")
+		if !ok || cluster == "" || path == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		r = r.Clone(r.Context())
+		r.URL.Path = "/api/" + path
+		r.URL.RawPath = ""
+		r.Header.Set(runtime.MetadataHeaderPrefix+ClusterMetadataKey, cluster)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ProbeHandler responds with 200 if check passed and 503 otherwise.
+func ProbeHandler(check func() bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		if !check() {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}
+}
+
 func handleGET(mux *runtime.ServeMux, path string, handler http.HandlerFunc) {
 	err := mux.HandlePath("GET", path, func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
 		handler(w, r)
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"runtime/debug"
+	"strings"
 	"time"
 
 	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/audit"
 	xctx "github.com/clyso/ceph-api/pkg/ctx"
 	"github.com/clyso/ceph-api/pkg/log"
 	"github.com/clyso/ceph-api/pkg/trace"
@@ -16,6 +18,7 @@ import (
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/rs/zerolog"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	otel_trace "go.opentelemetry.io/otel/trace"
@@ -23,6 +26,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 )
@@ -33,6 +37,8 @@ func NewGrpcServer(conf Config,
 	authAPI pb.AuthServer,
 	crushRuleAPI pb.CrushRuleServer,
 	statusAPI pb.StatusServer,
+	auditAPI pb.AuditServer,
+	auditLog *audit.Log,
 	authN grpc_auth.AuthFunc,
 	tracer otel_trace.TracerProvider,
 	logConf log.Config) *grpc.Server {
@@ -57,8 +63,11 @@ func NewGrpcServer(conf Config,
 			grpc_auth.UnaryServerInterceptor(authN),
 			log.UnaryInterceptor(logConf),
 			trace.UnaryInterceptor(),
+			unaryServerMetadata,
+			unaryServerAudit(auditLog),
 			ErrorInterceptor(),
 			unaryServerAccessLog(conf.AccessLog),
+			unaryServerRbac,
 			unaryServerRecover,
 		)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
@@ -67,8 +76,11 @@ func NewGrpcServer(conf Config,
 			grpc_auth.StreamServerInterceptor(authN),
 			log.StreamInterceptor(logConf),
 			trace.StreamInterceptor(),
+			streamServerMetadata,
+			streamServerAudit(auditLog),
 			ErrorStreamInterceptor(),
 			streamServerAccessLog(conf.AccessLog),
+			streamServerRbac,
 			streamServerRecover,
 		)))
 
@@ -78,6 +90,7 @@ func NewGrpcServer(conf Config,
 	pb.RegisterAuthServer(srv, authAPI)
 	pb.RegisterCrushRuleServer(srv, crushRuleAPI)
 	pb.RegisterStatusServer(srv, statusAPI)
+	pb.RegisterAuditServer(srv, auditAPI)
 	if conf.GrpcReflection {
 		reflection.Register(srv)
 	}
@@ -120,6 +133,37 @@ func streamServerRecover(srv any, stream grpc.ServerStream, _ *grpc.StreamServer
 	return handler(srv, stream)
 }
 
+// ClusterMetadataKey - grpc metadata key to select Ceph cluster for request.
+const ClusterMetadataKey = "ceph-cluster"
+
+// unaryServerMetadata applies request options from grpc metadata:
+// Ceph cluster selector and "Cache-Control: no-cache" to bypass RADOS read cache.
+func unaryServerMetadata(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(withMetadataOpts(ctx), req)
+}
+
+func streamServerMetadata(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	wrapped := grpc_middleware.WrapServerStream(stream)
+	wrapped.WrappedContext = withMetadataOpts(stream.Context())
+	return handler(srv, wrapped)
+}
+
+func withMetadataOpts(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if cluster := md.Get(ClusterMetadataKey); len(cluster) != 0 {
+		ctx = xctx.SetCluster(ctx, cluster[0])
+	}
+	// grpc-gateway forwards http Cache-Control header with prefix
+	for _, key := range []string{"cache-control", runtime.MetadataPrefix + "cache-control"} {
+		for _, val := range md.Get(key) {
+			if strings.Contains(strings.ToLower(val), "no-cache") {
+				return xctx.SetNoCache(ctx)
+			}
+		}
+	}
+	return ctx
+}
+
 func unaryServerAccessLog(enableAccessLog bool) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (_ any, err error) {
 		if enableAccessLog {
@@ -210,6 +254,27 @@ func convertApiError(ctx context.Context, err error) error {
 	case errors.Is(err, types.ErrAccessDenied):
 		code = codes.PermissionDenied
 		mappedErr = types.ErrAccessDenied
+	case errors.Is(err, types.ErrPwdChangeRequired):
+		code = codes.FailedPrecondition
+		mappedErr = types.ErrPwdChangeRequired
+		details = append(details, &errdetails.ErrorInfo{
+			Reason: "ErrPwdChangeRequired",
+		})
+	case errors.Is(err, types.ErrUnavailable):
+		code = codes.Unavailable
+		mappedErr = types.ErrUnavailable
+	case errors.Is(err, types.ErrAborted):
+		code = codes.Aborted
+		mappedErr = types.ErrAborted
+		details = append(details, &errdetails.ErrorInfo{
+			Reason: err.Error(),
+		})
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+		mappedErr = context.DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+		mappedErr = context.Canceled
 	default:
 		code = codes.Internal
 		mappedErr = types.ErrInternal
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/clyso/ceph-api/pkg/log"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func Test_GrpcServer_reflection(t *testing.T) {
+	r := require.New(t)
+	authN := func(ctx context.Context) (context.Context, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if len(md.Get("authorization")) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "no token")
+		}
+		return ctx, nil
+	}
+	srv := NewGrpcServer(Config{GrpcReflection: true}, nil, nil, nil, nil, nil, nil, nil, authN, noop.NewTracerProvider(), log.Config{Level: "error"})
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	r.NoError(err)
+	defer conn.Close()
+	listServices := func(ctx context.Context) ([]string, error) {
+		stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err = stream.Send(&rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_ListServices{}}); err != nil {
+			return nil, err
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		var res []string
+		for _, s := range resp.GetListServicesResponse().GetService() {
+			res = append(res, s.Name)
+		}
+		return res, nil
+	}
+
+	_, err = listServices(context.Background())
+	r.Equal(codes.Unauthenticated, status.Code(err))
+
+	services, err := listServices(metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token"))
+	r.NoError(err, "reflection is available for any authenticated caller")
+	r.Contains(services, "ceph.Users")
+}
@@ -1,6 +1,9 @@
 package api
 
-import "google.golang.org/protobuf/types/known/timestamppb"
+import (
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
 
 func tsToPb(in *int) *timestamppb.Timestamp {
 	if in == nil {
@@ -8,3 +11,14 @@ func tsToPb(in *int) *timestamppb.Timestamp {
 	}
 	return &timestamppb.Timestamp{Seconds: int64(*in)}
 }
+
+func permissionsToPb(in map[string][]string) map[string]*structpb.ListValue {
+	res := make(map[string]*structpb.ListValue, len(in))
+	for scope, perms := range in {
+		res[scope] = &structpb.ListValue{}
+		for _, p := range perms {
+			res[scope].Values = append(res[scope].Values, structpb.NewStringValue(p))
+		}
+	}
+	return res
+}
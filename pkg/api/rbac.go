@@ -0,0 +1,237 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// rbacRule - access requirement of gRPC method declared with (ceph.rbac) option in proto files.
+type rbacRule struct {
+	method        string
+	public        bool
+	authenticated bool
+	scope         user.Scope
+	perms         []user.Permission
+	resource      protoreflect.FieldDescriptor
+	anyResource   bool
+	// audit - method call is written to audit log
+	audit bool
+}
+
+// rbacRules - access requirements of API methods by full gRPC method name, e.g. "/ceph.Users/ListUsers".
+var rbacRules map[string]rbacRule = func() map[string]rbacRule {
+	res, err := parseRbacRules(pb.File_rbac_proto.Package())
+	if err != nil {
+		panic(err)
+	}
+	return res
+}()
+
+// serviceRbacRules - access requirements of services registered from other packages, by full service name.
+var serviceRbacRules = map[string]rbacRule{
+	"grpc.reflection.v1.ServerReflection":      {authenticated: true},
+	"grpc.reflection.v1alpha.ServerReflection": {authenticated: true},
+	"grpc.health.v1.Health":                    {public: true},
+}
+
+// findRbacRule returns declared access requirement of full gRPC method name.
+func findRbacRule(method string) (rbacRule, bool) {
+	if rule, ok := rbacRules[method]; ok {
+		return rule, true
+	}
+	service, _, ok := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	if !ok {
+		return rbacRule{}, false
+	}
+	rule, ok := serviceRbacRules[service]
+	rule.method = method
+	return rule, ok
+}
+
+func parseRbacRules(pkg protoreflect.FullName) (map[string]rbacRule, error) {
+	res := map[string]rbacRule{}
+	var err error
+	protoregistry.GlobalFiles.RangeFilesByPackage(pkg, func(fd protoreflect.FileDescriptor) bool {
+		for i := 0; i < fd.Services().Len() && err == nil; i++ {
+			service := fd.Services().Get(i)
+			for j := 0; j < service.Methods().Len() && err == nil; j++ {
+				md := service.Methods().Get(j)
+				if !proto.HasExtension(md.Options(), pb.E_Rbac) {
+					continue
+				}
+				var rule rbacRule
+				rule, err = parseRbacRule(md, proto.GetExtension(md.Options(), pb.E_Rbac).(*pb.RbacRule))
+				res[rule.method] = rule
+			}
+		}
+		return err == nil
+	})
+	return res, err
+}
+
+func parseRbacRule(md protoreflect.MethodDescriptor, opt *pb.RbacRule) (rbacRule, error) {
+	res := rbacRule{
+		method:        fmt.Sprintf("/%s/%s", md.Parent().FullName(), md.Name()),
+		public:        opt.Public,
+		authenticated: opt.Authenticated,
+		anyResource:   opt.AnyResource,
+		audit:         opt.Audit,
+	}
+	switch {
+	case opt.Public && opt.Authenticated:
+		return res, fmt.Errorf("rbac rule of %s: public and authenticated are mutually exclusive", res.method)
+	case opt.Public || opt.Authenticated:
+		if opt.Scope != "" || len(opt.Permissions) != 0 || opt.ResourceField != "" || opt.AnyResource {
+			return res, fmt.Errorf("rbac rule of %s: public or authenticated method cannot have scope permissions", res.method)
+		}
+		return res, nil
+	}
+	if len(opt.Permissions) == 0 {
+		return res, fmt.Errorf("rbac rule of %s: permissions are not set", res.method)
+	}
+	var err error
+	res.scope, res.perms, err = user.ParseScopePermissions(opt.Scope, opt.Permissions...)
+	if err != nil {
+		return res, fmt.Errorf("rbac rule of %s: %w", res.method, err)
+	}
+	// mutating methods are always audited
+	for _, p := range res.perms {
+		res.audit = res.audit || p != user.PermRead
+	}
+	if opt.ResourceField == "" {
+		return res, nil
+	}
+	if opt.AnyResource {
+		return res, fmt.Errorf("rbac rule of %s: resource_field and any_resource are mutually exclusive", res.method)
+	}
+	res.resource = md.Input().Fields().ByName(protoreflect.Name(opt.ResourceField))
+	if res.resource == nil || res.resource.Kind() != protoreflect.StringKind || res.resource.IsMap() {
+		return res, fmt.Errorf("rbac rule of %s: resource_field %q is not a string field of %s", res.method, opt.ResourceField, md.Input().FullName())
+	}
+	return res, nil
+}
+
+// IsPublicMethod reports whether gRPC method is declared as available without authentication.
+func IsPublicMethod(method string) bool {
+	rule, _ := findRbacRule(method)
+	return rule.public
+}
+
+// checkRbac enforces declared access requirement of method. Request is nil for streams.
+func checkRbac(ctx context.Context, method string, req any) error {
+	rule, ok := findRbacRule(method)
+	if !ok {
+		zerolog.Ctx(ctx).Error().Str("method", method).Msg("rbac: method has no declared access requirement")
+		return status.Errorf(codes.PermissionDenied, "method %s has no declared access requirement", method)
+	}
+	switch {
+	case rule.public, rule.authenticated:
+		return nil
+	case rule.anyResource:
+		return user.HasAnyResourcePermissions(ctx, rule.scope, rule.perms...)
+	case rule.resource != nil:
+		for _, resource := range requestResources(req, rule.resource) {
+			if err := user.HasResourcePermissions(ctx, rule.scope, resource, rule.perms...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return user.HasPermissions(ctx, rule.scope, rule.perms...)
+}
+
+// requestResources returns resource names from request field. Returns single empty name
+// if request or field is empty, so only scope-level permissions are accepted.
+func requestResources(req any, field protoreflect.FieldDescriptor) []string {
+	msg, ok := req.(proto.Message)
+	if !ok || msg == nil {
+		return []string{""}
+	}
+	val := msg.ProtoReflect().Get(field)
+	if !field.IsList() {
+		return []string{val.String()}
+	}
+	list := val.List()
+	if list.Len() == 0 {
+		return []string{""}
+	}
+	res := make([]string, list.Len())
+	for i := range res {
+		res[i] = list.Get(i).String()
+	}
+	return res
+}
+
+func unaryServerRbac(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := checkRbac(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func streamServerRbac(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := checkRbac(stream.Context(), info.FullMethod, nil); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
+
+// RbacTable returns markdown table with access requirements of API methods.
+func RbacTable() string {
+	rules := make(map[string]rbacRule, len(rbacRules)+len(serviceRbacRules))
+	for method, rule := range rbacRules {
+		rules[method] = rule
+	}
+	for service, rule := range serviceRbacRules {
+		rules["/"+service+"/*"] = rule
+	}
+	methods := make([]string, 0, len(rules))
+	for method := range rules {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	var b strings.Builder
+	b.WriteString("| Method | Scope | Permissions | Resource | Audit |\n")
+	b.WriteString("|---|---|---|---|---|\n")
+	for _, method := range methods {
+		rule := rules[method]
+		scope, perms, resource := "", "", ""
+		switch {
+		case rule.public:
+			scope = "*public*"
+		case rule.authenticated:
+			scope = "*authenticated*"
+		default:
+			scope = "`" + string(rule.scope) + "`"
+			names := make([]string, len(rule.perms))
+			for i, p := range rule.perms {
+				names[i] = p.String()
+			}
+			perms = strings.Join(names, ", ")
+		}
+		switch {
+		case rule.anyResource:
+			resource = "any, result is filtered"
+		case rule.resource != nil:
+			resource = "`" + string(rule.resource.Name()) + "`"
+		}
+		audited := ""
+		if rule.audit {
+			audited = "yes"
+		}
+		fmt.Fprintf(&b, "| `%s` | %s | %s | %s | %s |\n", method, scope, perms, resource, audited)
+	}
+	return b.String()
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"context"
+	"flag"
+	"os"
+	"testing"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+var updateDocs = flag.Bool("update", false, "regenerate docs/rbac.md")
+
+const rbacDocPath = "../../docs/rbac.md"
+
+const rbacDocHeader = `# API method permissions
+
+<!-- Generated from (ceph.rbac) options in api/*.proto. Do not edit, run: go test ./pkg/api -run Test_RbacDoc -update -->
+
+Each gRPC method (and its HTTP route from [http.yaml](../api/http.yaml)) declares required scope permissions with ` + "`(ceph.rbac)`" + ` option.
+Methods without declaration are rejected with ` + "`PERMISSION_DENIED`" + `. Methods with resource field also accept
+resource-level permissions (` + "`<scope>[@<cluster>]:<pattern>`" + `) matching the field value.
+
+`
+
+func Test_RbacRules_allMethodsDeclared(t *testing.T) {
+	r := require.New(t)
+	protoregistry.GlobalFiles.RangeFilesByPackage(pb.File_rbac_proto.Package(), func(fd protoreflect.FileDescriptor) bool {
+		for i := 0; i < fd.Services().Len(); i++ {
+			service := fd.Services().Get(i)
+			for j := 0; j < service.Methods().Len(); j++ {
+				method := "/" + string(service.FullName()) + "/" + string(service.Methods().Get(j).Name())
+				r.Contains(rbacRules, method, "method has no (ceph.rbac) option")
+			}
+		}
+		return true
+	})
+	r.True(IsPublicMethod("/ceph.Auth/Login"))
+	// login and logout are audited by OAuth endpoints
+	r.False(rbacRules["/ceph.Auth/Login"].audit)
+	r.False(rbacRules["/ceph.Auth/Logout"].audit)
+	r.True(IsPublicMethod("/ceph.Users/ValidatePassword"))
+	r.False(IsPublicMethod("/ceph.Users/ListUsers"))
+	r.False(IsPublicMethod("/ceph.Unknown/Method"))
+	r.True(IsPublicMethod("/grpc.health.v1.Health/Check"))
+	r.False(IsPublicMethod("/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"))
+}
+
+func Test_checkRbac(t *testing.T) {
+	r := require.New(t)
+	ctx := xctx.SetPermissions(context.Background(), map[string][]string{
+		"osd:tenant-a-*": {"read", "delete"},
+		"monitor":        {"read"},
+		"config-opt":     {"read"},
+		"user":           {"read"},
+	})
+
+	err := checkRbac(ctx, "/ceph.Unknown/Method", nil)
+	r.Equal(codes.PermissionDenied, status.Code(err))
+	r.NoError(checkRbac(context.Background(), "/ceph.Auth/Login", &pb.LoginReq{}))
+	r.NoError(checkRbac(ctx, "/ceph.Auth/WhoAmI", nil))
+	r.NoError(checkRbac(ctx, "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo", nil))
+	r.Equal(codes.PermissionDenied, status.Code(checkRbac(ctx, "/grpc.reflection.v1.Unknown/Method", nil)))
+
+	r.NoError(checkRbac(ctx, "/ceph.Status/GetCephStatus", nil))
+	r.ErrorIs(checkRbac(ctx, "/ceph.Status/GetCephOsdDump", nil), types.ErrAccessDenied)
+
+	r.NoError(checkRbac(ctx, "/ceph.CrushRule/GetRule", &pb.GetRuleRequest{Name: "tenant-a-rule"}))
+	r.ErrorIs(checkRbac(ctx, "/ceph.CrushRule/GetRule", &pb.GetRuleRequest{Name: "tenant-b-rule"}), types.ErrAccessDenied)
+	r.ErrorIs(checkRbac(ctx, "/ceph.CrushRule/CreateRule", &pb.CreateRuleRequest{Name: "tenant-a-rule"}), types.ErrAccessDenied)
+	r.NoError(checkRbac(ctx, "/ceph.CrushRule/ListRules", nil), "any resource permission is enough for list")
+	r.ErrorIs(checkRbac(ctx, "/ceph.CrushRule/GetRule", nil), types.ErrAccessDenied, "empty resource requires scope permission")
+
+	r.NoError(checkRbac(ctx, "/ceph.Cluster/ExportUser", &pb.ExportClusterUserReq{Entities: []string{"client.a", "client.b"}}))
+	r.ErrorIs(checkRbac(ctx, "/ceph.Cluster/DeleteUser", &pb.DeleteClusterUserReq{UserEntity: "client.a"}), types.ErrAccessDenied)
+
+	r.NoError(checkRbac(ctx, "/ceph.Users/PreviewImportAccessDB", &pb.ImportAccessDBReq{}))
+	r.ErrorIs(checkRbac(ctx, "/ceph.Users/ImportAccessDB", &pb.ImportAccessDBReq{}), types.ErrAccessDenied)
+	r.ErrorIs(checkRbac(ctx, "/ceph.Users/ExportAccessDB", nil), types.ErrAccessDenied, "backup with credentials requires restore permissions")
+	r.NoError(checkRbac(ctx, "/ceph.Users/ExportAccessDBWithoutCredentials", nil))
+}
+
+func Test_parseRbacRule(t *testing.T) {
+	md := pb.File_crush_rule_proto.Services().ByName("CrushRule").Methods().ByName("GetRule")
+	for name, opt := range map[string]*pb.RbacRule{
+		"nothing declared":         {},
+		"public and scope":         {Public: true, Scope: "pool", Permissions: []string{"read"}},
+		"unknown scope":            {Scope: "pools", Permissions: []string{"read"}},
+		"unknown permission":       {Scope: "pool", Permissions: []string{"list"}},
+		"unknown resource field":   {Scope: "pool", Permissions: []string{"read"}, ResourceField: "rule_name"},
+		"resource and any":         {Scope: "pool", Permissions: []string{"read"}, ResourceField: "name", AnyResource: true},
+		"public and authenticated": {Public: true, Authenticated: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseRbacRule(md, opt)
+			require.Error(t, err)
+		})
+	}
+	rule, err := parseRbacRule(md, &pb.RbacRule{Scope: "pool", Permissions: []string{"read"}, ResourceField: "name"})
+	require.NoError(t, err)
+	require.EqualValues(t, "/ceph.CrushRule/GetRule", rule.method)
+}
+
+func Test_RbacDoc(t *testing.T) {
+	doc := rbacDocHeader + RbacTable()
+	if *updateDocs {
+		require.NoError(t, os.WriteFile(rbacDocPath, []byte(doc), 0o644))
+	}
+	existing, err := os.ReadFile(rbacDocPath)
+	require.NoError(t, err)
+	require.Equal(t, doc, string(existing), "docs/rbac.md is outdated, run: go test ./pkg/api -run Test_RbacDoc -update")
+}
@@ -7,26 +7,21 @@ import (
 	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
 	"github.com/clyso/ceph-api/pkg/rados"
 	"github.com/clyso/ceph-api/pkg/types"
-	"github.com/clyso/ceph-api/pkg/user"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func NewStatusAPI(radosSvc *rados.Svc) pb.StatusServer {
+func NewStatusAPI(radosSvc *rados.Clusters) pb.StatusServer {
 	return &statusAPI{
 		radosSvc: radosSvc,
 	}
 }
 
 type statusAPI struct {
-	radosSvc *rados.Svc
+	radosSvc *rados.Clusters
 }
 
 func (s *statusAPI) GetCephStatus(ctx context.Context, body *emptypb.Empty) (*pb.GetCephStatusResponse, error) {
-	if err := user.HasPermissions(ctx, user.ScopeMonitor, user.PermRead); err != nil {
-		return nil, err
-	}
-
 	const cmdTempl = `{"prefix": "status", "format": "json"}`
 	res, err := s.radosSvc.ExecMon(ctx, cmdTempl)
 	if err != nil {
@@ -41,10 +36,6 @@ func (s *statusAPI) GetCephStatus(ctx context.Context, body *emptypb.Empty) (*pb
 }
 
 func (s *statusAPI) GetCephMonDump(ctx context.Context, req *emptypb.Empty) (*pb.CephMonDumpResponse, error) {
-	if err := user.HasPermissions(ctx, user.ScopeMonitor, user.PermRead); err != nil {
-		return nil, err
-	}
-
 	const cmdTempl = `{"prefix": "mon dump", "format": "json"}`
 	res, err := s.radosSvc.ExecMon(ctx, cmdTempl)
 	if err != nil {
@@ -78,10 +69,6 @@ func (s *statusAPI) GetCephMonDump(ctx context.Context, req *emptypb.Empty) (*pb
 }
 
 func (s *statusAPI) GetCephOsdDump(ctx context.Context, body *emptypb.Empty) (*pb.GetCephOsdDumpResponse, error) {
-	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermRead); err != nil {
-		return nil, err
-	}
-
 	const cmdTempl = `{"prefix": "osd dump", "format": "json"}`
 	res, err := s.radosSvc.ExecMon(ctx, cmdTempl)
 	if err != nil {
@@ -2,6 +2,8 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
 	xctx "github.com/clyso/ceph-api/pkg/ctx"
@@ -12,20 +14,20 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func NewUsersAPI(svc *user.Service) pb.UsersServer {
+func NewUsersAPI(svc *user.Service, backupKey []byte) pb.UsersServer {
 	return &usersAPI{
-		svc: svc,
+		svc:       svc,
+		backupKey: backupKey,
 	}
 }
 
 type usersAPI struct {
 	svc *user.Service
+	// backupKey - key to sign and verify access DB backups.
+	backupKey []byte
 }
 
 func (u *usersAPI) CloneRole(ctx context.Context, req *pb.CloneRoleReq) (*emptypb.Empty, error) {
-	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermRead, user.PermCreate); err != nil {
-		return nil, err
-	}
 	err := u.svc.CloneRole(ctx, req.Name, req.NewName)
 	if err != nil {
 		return nil, err
@@ -34,9 +36,6 @@ func (u *usersAPI) CloneRole(ctx context.Context, req *pb.CloneRoleReq) (*emptyp
 }
 
 func (u *usersAPI) CreateRole(ctx context.Context, req *pb.Role) (*emptypb.Empty, error) {
-	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermCreate); err != nil {
-		return nil, err
-	}
 	err := u.svc.CreateRole(ctx, roleFromPb(req))
 	if err != nil {
 		return nil, err
@@ -61,9 +60,6 @@ func roleFromPb(r *pb.Role) user.Role {
 }
 
 func (u *usersAPI) DeleteRole(ctx context.Context, req *pb.GetRoleReq) (*emptypb.Empty, error) {
-	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermDelete); err != nil {
-		return nil, err
-	}
 	err := u.svc.DeleteRole(ctx, req.Name)
 	if err != nil {
 		return nil, err
@@ -72,9 +68,6 @@ func (u *usersAPI) DeleteRole(ctx context.Context, req *pb.GetRoleReq) (*emptypb
 }
 
 func (u *usersAPI) GetRole(ctx context.Context, req *pb.GetRoleReq) (*pb.Role, error) {
-	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermRead); err != nil {
-		return nil, err
-	}
 	role, err := u.svc.GetRole(ctx, req.Name)
 	if err != nil {
 		return nil, err
@@ -98,9 +91,6 @@ func roleToPb(r user.Role) *pb.Role {
 }
 
 func (u *usersAPI) ListRoles(ctx context.Context, _ *emptypb.Empty) (*pb.RolesResp, error) {
-	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermRead); err != nil {
-		return nil, err
-	}
 	roles, err := u.svc.ListRoles(ctx)
 	if err != nil {
 		return nil, err
@@ -113,9 +103,6 @@ func (u *usersAPI) ListRoles(ctx context.Context, _ *emptypb.Empty) (*pb.RolesRe
 }
 
 func (u *usersAPI) UpdateRole(ctx context.Context, req *pb.Role) (*emptypb.Empty, error) {
-	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermUpdate); err != nil {
-		return nil, err
-	}
 	err := u.svc.UpdateRole(ctx, roleFromPb(req))
 	if err != nil {
 		return nil, err
@@ -134,9 +121,22 @@ func (u *usersAPI) UserChangePassword(ctx context.Context, req *pb.UserChangePas
 	return &emptypb.Empty{}, nil
 }
 
+func (u *usersAPI) ValidatePassword(ctx context.Context, req *pb.ValidatePasswordReq) (*pb.ValidatePasswordResp, error) {
+	violations := u.svc.ValidatePassword(req.Username, req.Password, req.OldPassword)
+	res := &pb.ValidatePasswordResp{
+		Valid:      len(violations) == 0,
+		Complexity: int32(user.PasswordComplexity(req.Password)),
+		Violations: make([]*pb.PasswordViolation, len(violations)),
+	}
+	for i, v := range violations {
+		res.Violations[i] = &pb.PasswordViolation{Rule: v.Rule, Message: v.Message}
+	}
+	return res, nil
+}
+
 func (u *usersAPI) CreateUser(ctx context.Context, req *pb.CreateUserReq) (*emptypb.Empty, error) {
-	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermCreate); err != nil {
-		return nil, err
+	if req.ClearPwdExpirationDate {
+		return nil, fmt.Errorf("%w: password expiration date can be cleared only on user update", types.ErrInvalidArg)
 	}
 	usr := user.User{
 		Username:          req.Username,
@@ -145,13 +145,22 @@ func (u *usersAPI) CreateUser(ctx context.Context, req *pb.CreateUserReq) (*empt
 		Name:              req.Name,
 		Email:             req.Email,
 		Enabled:           req.Enabled,
-		PwdUpdateRequired: false,
+		PwdUpdateRequired: req.PwdUpdateRequired,
 	}
 	if req.PwdExpirationDate != nil {
 		var expIn int = int(req.PwdExpirationDate.Seconds)
 		usr.PwdExpirationDate = &expIn
 	}
-	err := u.svc.CreateUser(ctx, usr)
+	var err error
+	if req.PasswordHash != "" {
+		if req.Password != "" {
+			return nil, fmt.Errorf("%w: either password or password hash must be set", types.ErrInvalidArg)
+		}
+		usr.Password = req.PasswordHash
+		err = u.svc.ImportUser(ctx, usr)
+	} else {
+		err = u.svc.CreateUser(ctx, usr)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -159,9 +168,6 @@ func (u *usersAPI) CreateUser(ctx context.Context, req *pb.CreateUserReq) (*empt
 }
 
 func (u *usersAPI) DeleteUser(ctx context.Context, req *pb.GetUserReq) (*emptypb.Empty, error) {
-	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermDelete); err != nil {
-		return nil, err
-	}
 	err := u.svc.DeleteUser(ctx, req.Username)
 	if err != nil {
 		return nil, err
@@ -170,9 +176,6 @@ func (u *usersAPI) DeleteUser(ctx context.Context, req *pb.GetUserReq) (*emptypb
 }
 
 func (u *usersAPI) GetUser(ctx context.Context, req *pb.GetUserReq) (*pb.User, error) {
-	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermRead); err != nil {
-		return nil, err
-	}
 	usr, err := u.svc.GetUser(ctx, req.Username)
 	if err != nil {
 		return nil, err
@@ -189,6 +192,8 @@ func userToPb(usr user.User) *pb.User {
 		PwdUpdateRequired: usr.PwdUpdateRequired,
 		Roles:             usr.Roles,
 		Username:          usr.Username,
+		ServiceAccount:    usr.ServiceAccount,
+		MfaEnabled:        usr.MFAEnabled(),
 	}
 	if usr.PwdExpirationDate != nil {
 		res.PwdExpirationDate = &timestamppb.Timestamp{Seconds: int64(*usr.PwdExpirationDate)}
@@ -197,9 +202,6 @@ func userToPb(usr user.User) *pb.User {
 }
 
 func (u *usersAPI) ListUsers(ctx context.Context, _ *emptypb.Empty) (*pb.UsersResp, error) {
-	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermRead); err != nil {
-		return nil, err
-	}
 	users, err := u.svc.ListUsers(ctx)
 	if err != nil {
 		return nil, err
@@ -212,8 +214,8 @@ func (u *usersAPI) ListUsers(ctx context.Context, _ *emptypb.Empty) (*pb.UsersRe
 }
 
 func (u *usersAPI) UpdateUser(ctx context.Context, req *pb.CreateUserReq) (*emptypb.Empty, error) {
-	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermUpdate); err != nil {
-		return nil, err
+	if req.PasswordHash != "" {
+		return nil, fmt.Errorf("%w: password hash can be set only on user creation", types.ErrInvalidArg)
 	}
 	usr := user.User{
 		Username:          req.Username,
@@ -228,9 +230,112 @@ func (u *usersAPI) UpdateUser(ctx context.Context, req *pb.CreateUserReq) (*empt
 		var expIn int = int(req.PwdExpirationDate.Seconds)
 		usr.PwdExpirationDate = &expIn
 	}
-	err := u.svc.UpdateUser(ctx, usr)
+	err := u.svc.UpdateUser(ctx, usr, req.ClearPwdExpirationDate)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (u *usersAPI) CreateServiceAccount(ctx context.Context, req *pb.CreateServiceAccountReq) (*emptypb.Empty, error) {
+	err := u.svc.CreateServiceAccount(ctx, req.Username, req.Name, req.Roles)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (u *usersAPI) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyReq) (*pb.APIKeyCreated, error) {
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		exp := req.ExpiresAt.AsTime()
+		expiresAt = &exp
+	}
+	key, info, err := u.svc.CreateAPIKey(ctx, req.Username, req.Name, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.APIKeyCreated{Info: apiKeyToPb(info), Key: key}, nil
+}
+
+func apiKeyToPb(k user.APIKey) *pb.APIKey {
+	res := &pb.APIKey{
+		Name:      k.Name,
+		CreatedAt: &timestamppb.Timestamp{Seconds: k.CreatedAt},
+	}
+	if k.ExpiresAt != nil {
+		res.ExpiresAt = &timestamppb.Timestamp{Seconds: *k.ExpiresAt}
+	}
+	if k.LastUsed != nil {
+		res.LastUsed = &timestamppb.Timestamp{Seconds: *k.LastUsed}
+	}
+	return res
+}
+
+func (u *usersAPI) ListAPIKeys(ctx context.Context, req *pb.GetUserReq) (*pb.APIKeysResp, error) {
+	keys, err := u.svc.ListAPIKeys(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*pb.APIKey, len(keys))
+	for i, k := range keys {
+		res[i] = apiKeyToPb(k)
+	}
+	return &pb.APIKeysResp{Keys: res}, nil
+}
+
+func (u *usersAPI) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyReq) (*emptypb.Empty, error) {
+	err := u.svc.RevokeAPIKey(ctx, req.Username, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (u *usersAPI) ResetMFA(ctx context.Context, req *pb.GetUserReq) (*emptypb.Empty, error) {
+	err := u.svc.ResetMFA(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (u *usersAPI) ExportAccessDB(ctx context.Context, _ *emptypb.Empty) (*pb.AccessDBBackup, error) {
+	return u.exportAccessDB(ctx, false)
+}
+
+func (u *usersAPI) ExportAccessDBWithoutCredentials(ctx context.Context, _ *emptypb.Empty) (*pb.AccessDBBackup, error) {
+	return u.exportAccessDB(ctx, true)
+}
+
+func (u *usersAPI) exportAccessDB(ctx context.Context, withoutCredentials bool) (*pb.AccessDBBackup, error) {
+	data, err := u.svc.ExportDB(ctx, u.backupKey, withoutCredentials)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AccessDBBackup{Data: string(data)}, nil
+}
+
+func (u *usersAPI) PreviewImportAccessDB(ctx context.Context, req *pb.ImportAccessDBReq) (*pb.AccessDBChanges, error) {
+	return u.importAccessDB(ctx, req, true)
+}
+
+func (u *usersAPI) ImportAccessDB(ctx context.Context, req *pb.ImportAccessDBReq) (*pb.AccessDBChanges, error) {
+	return u.importAccessDB(ctx, req, false)
+}
+
+func (u *usersAPI) importAccessDB(ctx context.Context, req *pb.ImportAccessDBReq, dryRun bool) (*pb.AccessDBChanges, error) {
+	opts := user.ImportOpts{Mode: user.ImportReplace, DryRun: dryRun, SkipSignature: req.SkipSignature}
+	if req.Mode == pb.ImportAccessDBReq_MERGE {
+		opts.Mode = user.ImportMerge
+	}
+	changes, err := u.svc.ImportDB(ctx, []byte(req.Data), u.backupKey, opts)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.AccessDBChanges{Applied: !dryRun, Changes: make([]*pb.AccessDBChange, len(changes))}
+	for i, c := range changes {
+		res.Changes[i] = &pb.AccessDBChange{Kind: c.Kind, Name: c.Name, Action: c.Action, Fields: c.Fields}
+	}
+	return res, nil
+}
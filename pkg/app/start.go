@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/clyso/ceph-api/pkg/api"
+	"github.com/clyso/ceph-api/pkg/audit"
 	"github.com/clyso/ceph-api/pkg/auth"
 	"github.com/clyso/ceph-api/pkg/config"
 	"github.com/clyso/ceph-api/pkg/log"
@@ -36,9 +37,22 @@ func Start(ctx context.Context, conf config.Config, build config.Build) error {
 		return err
 	}
 	defer radosSvc.Close()
+	namedClusters := make(map[string]*rados.Svc, len(conf.Clusters))
+	for name, clusterConf := range conf.Clusters {
+		if err = rados.ValidateClusterName(name); err != nil {
+			return err
+		}
+		clusterSvc, err := rados.New(clusterConf)
+		if err != nil {
+			return fmt.Errorf("%w: unable to connect to cluster %q", err, name)
+		}
+		defer clusterSvc.Close()
+		namedClusters[name] = clusterSvc
+	}
+	clusters := rados.NewClusters(radosSvc, namedClusters)
 
-	clusterAPI := api.NewClusterAPI(radosSvc)
-	userSvc, err := user.New(radosSvc)
+	clusterAPI := api.NewClusterAPI(clusters)
+	userSvc, err := user.New(radosSvc, clusters.Names(), conf.App.PwdPolicy, conf.App.BcryptPwdCost)
 	if err != nil {
 		return err
 	}
@@ -56,13 +70,18 @@ func Start(ctx context.Context, conf config.Config, build config.Build) error {
 				return fmt.Errorf("%w: unable to create admin user", err)
 			}
 		} else if err == nil {
+			adminPassword := conf.App.AdminPassword
+			if userSvc.CheckPassword(ctx, conf.App.AdminUsername, adminPassword) == nil {
+				// keep unchanged password to not reject it by password policy on every start
+				adminPassword = ""
+			}
 			err = userSvc.UpdateUser(ctx, user.User{
 				Username: conf.App.AdminUsername,
 				Roles:    []string{"administrator"},
-				Password: conf.App.AdminPassword,
+				Password: adminPassword,
 				Name:     util.StrPtr("ceph api default administrator"),
 				Enabled:  true,
-			})
+			}, false)
 			if err != nil {
 				return fmt.Errorf("%w: unable to update admin user", err)
 			}
@@ -70,34 +89,51 @@ func Start(ctx context.Context, conf config.Config, build config.Build) error {
 			logger.Info().Err(err).Msg("skip default administrator creation")
 		}
 	}
-	usersAPI := api.NewUsersAPI(userSvc)
-
-	authServer, err := auth.NewServer(conf.Auth, userSvc)
+	auditLog, err := audit.New(ctx, conf.Audit, radosSvc)
+	if err != nil {
+		return err
+	}
+	defer auditLog.Close()
+	authServer, err := auth.NewServer(conf.Auth, userSvc, radosSvc, auditLog)
 	if err != nil {
 		return err
 	}
+	usersAPI := api.NewUsersAPI(userSvc, authServer.BackupKey())
 	authAPI := api.NewAuthAPI(authServer)
 
 	server := util.NewServer()
 
-	crushRuleAPI := api.NewCrushRuleAPI(radosSvc)
+	crushRuleAPI := api.NewCrushRuleAPI(clusters)
 
-	statusAPI := api.NewStatusAPI(radosSvc)
+	statusAPI := api.NewStatusAPI(clusters)
 
-	authChecker := auth.AuthFunc(userSvc, authServer.Provider(), authServer.GetPublicKey)
-	grpcServer := api.NewGrpcServer(conf.Api, clusterAPI, usersAPI, authAPI, crushRuleAPI, statusAPI, authChecker, tp, conf.Log)
+	auditAPI := api.NewAuditAPI(auditLog)
+
+	externalIssuers, err := auth.NewExternalIssuers(conf.Auth.Issuers)
+	if err != nil {
+		return err
+	}
+	authChecker := auth.AuthFunc(userSvc, authServer.Provider(), authServer.GetPublicKey, authServer.ClientRoles, externalIssuers, api.IsPublicMethod)
+	grpcServer := api.NewGrpcServer(conf.Api, clusterAPI, usersAPI, authAPI, crushRuleAPI, statusAPI, auditAPI, auditLog, authChecker, tp, conf.Log)
 
 	var metricsHandler http.HandlerFunc
 	if conf.Metrics.Enabled {
 		metricsHandler = promhttp.Handler().ServeHTTP
 	}
-	oauthHandlers := map[string]http.HandlerFunc{
+	postHandlers := map[string]http.HandlerFunc{
 		"/api/oauth/token":      authServer.TokenEndpoint,
 		"/api/oauth/auth":       authServer.AuthEndpoint,
 		"/api/oauth/revoke":     authServer.RevokeEndpoint,
 		"/api/oauth/introspect": authServer.IntrospectionEndpoint,
 	}
-	httpServer, err := api.GRPCGateway(ctx, conf.Api, metricsHandler, oauthHandlers)
+	getHandlers := map[string]http.HandlerFunc{
+		"/api/oauth/auth":                   authServer.AuthEndpoint,
+		"/health/live":                      api.ProbeHandler(func() bool { return true }),
+		"/health/ready":                     api.ProbeHandler(radosSvc.Ready),
+		"/api/oauth/jwks":                   authServer.JWKSEndpoint,
+		"/.well-known/openid-configuration": authServer.DiscoveryEndpoint,
+	}
+	httpServer, err := api.GRPCGateway(ctx, conf.Api, metricsHandler, getHandlers, postHandlers)
 	if err != nil {
 		return err
 	}
@@ -109,6 +145,34 @@ func Start(ctx context.Context, conf config.Config, build config.Build) error {
 	if err != nil {
 		return err
 	}
+	err = server.Add("rados-health", radosSvc.HealthCheck, nil)
+	if err != nil {
+		return err
+	}
+	err = server.Add("access-db-reload", func(ctx context.Context) error {
+		return userSvc.ReloadDB(ctx, conf.App.AccessDBReloadInterval)
+	}, nil)
+	if err != nil {
+		return err
+	}
+	err = server.Add("api-key-usage", userSvc.StoreAPIKeyUsage, nil)
+	if err != nil {
+		return err
+	}
+	err = server.Add("oauth-key-rotation", authServer.RotateKeys, nil)
+	if err != nil {
+		return err
+	}
+	err = server.Add("oauth-token-cleanup", authServer.CleanupTokens, nil)
+	if err != nil {
+		return err
+	}
+	for name, clusterSvc := range namedClusters {
+		err = server.Add("rados-health-"+name, clusterSvc.HealthCheck, nil)
+		if err != nil {
+			return err
+		}
+	}
 
 	return server.Start(ctx)
 }
@@ -0,0 +1,262 @@
+package audit
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/rs/zerolog"
+)
+
+// Event - audit record of API call or authentication event.
+// Each event contains HMAC of the previous one, so removed or modified events break the chain.
+type Event struct {
+	Seq  uint64    `json:"seq"`
+	Time time.Time `json:"time"`
+	// Event - "rpc" for API calls or authentication event, e.g. "token_issued", "login_failed".
+	Event    string `json:"event"`
+	Username string `json:"username,omitempty"`
+	SourceIP string `json:"sourceIP,omitempty"`
+	Method   string `json:"method,omitempty"`
+	// Resources - identifiers of affected resources from request, e.g. {"username": "bob"}.
+	Resources map[string]string `json:"resources,omitempty"`
+	// Request - request body with redacted secrets.
+	Request  json.RawMessage `json:"request,omitempty"`
+	Code     string          `json:"code"`
+	Reason   string          `json:"reason,omitempty"`
+	TraceID  string          `json:"traceID,omitempty"`
+	PrevHash string          `json:"prevHash"`
+	Hash     string          `json:"hash"`
+}
+
+const EventRPC = "rpc"
+
+// computeHash returns hex encoded HMAC-SHA256 of event with empty hash. Event PrevHash is included.
+func (e Event) computeHash(key []byte) (string, error) {
+	e.Hash = ""
+	data, err := json.Marshal(&e)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+type sink interface {
+	write(line []byte) error
+	close() error
+}
+
+// Log - hash-chained audit log. Nil Log discards events.
+type Log struct {
+	mu       sync.Mutex
+	key      []byte
+	sink     sink
+	file     *fileSink
+	anchor   *anchorStore
+	seq      uint64
+	lastHash string
+	// anchorBroken - the newest events stored before start were removed or modified.
+	anchorBroken bool
+}
+
+// New creates audit log from config. Returns nil Log if audit is disabled.
+// Generated HMAC key and the last event of audit file are stored in Ceph config-key store of radosSvc.
+// If radosSvc is nil, key must be set with KeyFile and removal of the newest events is detected only while Log is open.
+func New(ctx context.Context, conf Config, radosSvc *rados.Svc) (*Log, error) {
+	var kv kvStore
+	if radosSvc != nil {
+		kv = &radosKV{radosSvc: radosSvc}
+	}
+	return newLog(ctx, conf, kv)
+}
+
+func newLog(ctx context.Context, conf Config, kv kvStore) (*Log, error) {
+	if !conf.Enabled {
+		return nil, nil
+	}
+	if err := conf.validate(); err != nil {
+		return nil, err
+	}
+	key, err := loadKey(ctx, conf, kv)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to load audit key", err)
+	}
+	res := &Log{key: key}
+	switch conf.Sink {
+	case SinkFile:
+		file, stored, err := openFileSink(conf.File)
+		if err != nil {
+			return nil, err
+		}
+		res.sink, res.file = file, file
+		// continue chain of previous run
+		if len(stored) != 0 {
+			res.seq, res.lastHash = stored[len(stored)-1].Seq, stored[len(stored)-1].Hash
+		}
+		if kv == nil {
+			break
+		}
+		res.anchor = newAnchorStore(kv, anchorID(conf.File), anchorFlushInterval)
+		anchor, err := res.anchor.load(ctx)
+		if err != nil {
+			res.Close()
+			return nil, fmt.Errorf("%w: unable to load audit chain anchor", err)
+		}
+		if !verifyAnchor(stored, anchor) {
+			res.anchorBroken = true
+			zerolog.Ctx(ctx).Error().Uint64("seq", anchor.Seq).Msg("audit log events were removed or modified while API was stopped")
+			res.Record(ctx, Event{
+				Event:  EventChainBroken,
+				Code:   "DataLoss",
+				Reason: fmt.Sprintf("event seq %d was removed or modified, the last stored seq is %d", anchor.Seq, res.seq),
+			})
+		}
+	case SinkStdout:
+		res.sink = stdoutSink{}
+	case SinkSyslog:
+		s, err := newSyslogSink(conf.Syslog)
+		if err != nil {
+			return nil, err
+		}
+		res.sink = s
+	}
+	return res, nil
+}
+
+// Record sets event sequence number, time and hash and writes it to the sink.
+// Username, source IP and trace ID are taken from context if not set.
+// Write errors are logged and do not fail the request.
+func (l *Log) Record(ctx context.Context, e Event) {
+	if l == nil {
+		return
+	}
+	if e.Username == "" {
+		e.Username = xctx.GetUsername(ctx)
+	}
+	if e.SourceIP == "" {
+		e.SourceIP = SourceIP(ctx)
+	}
+	if e.TraceID == "" {
+		e.TraceID = xctx.GetTraceID(ctx)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	e.Seq = l.seq + 1
+	e.Time = time.Now().UTC()
+	e.PrevHash = l.lastHash
+	hash, err := e.computeHash(l.key)
+	if err == nil {
+		e.Hash = hash
+		var line []byte
+		if line, err = json.Marshal(&e); err == nil {
+			err = l.sink.write(append(line, '\n'))
+		}
+	}
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("audit_event", e.Event).Str("grpc_method", e.Method).Msg("unable to write audit event")
+		return
+	}
+	l.seq, l.lastHash = e.Seq, e.Hash
+	if l.anchor != nil {
+		l.anchor.set(chainAnchor{Seq: e.Seq, Hash: e.Hash})
+	}
+}
+
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.anchor != nil {
+		l.anchor.close()
+		l.anchor = nil
+	}
+	return l.sink.close()
+}
+
+// anchorID returns ID of audit file writer: configured one or hostname with file path.
+func anchorID(conf FileConfig) string {
+	if conf.AnchorID != "" {
+		return conf.AnchorID
+	}
+	host, _ := os.Hostname()
+	path, err := filepath.Abs(conf.Path)
+	if err != nil {
+		path = conf.Path
+	}
+	sum := sha256.Sum256([]byte(host + ":" + path))
+	return hex.EncodeToString(sum[:8])
+}
+
+type ListOpts struct {
+	Since    time.Time
+	Username string
+	Method   string
+	Event    string
+	// Limit - max number of the most recent matching events.
+	Limit int
+}
+
+// List returns matching events from the oldest to the newest and verifies hash chain of all stored events.
+// ChainIntact is false if any stored event was modified, removed or inserted, or the newest events were removed.
+func (l *Log) List(ctx context.Context, opts ListOpts) (events []Event, chainIntact bool, err error) {
+	if l == nil {
+		return nil, false, fmt.Errorf("%w: audit log is disabled", types.ErrNotImplemented)
+	}
+	if l.file == nil {
+		return nil, false, fmt.Errorf("%w: audit events can be listed only from file sink", types.ErrNotImplemented)
+	}
+	// files are read without lock to not block Record
+	l.mu.Lock()
+	snap, err := l.file.snapshot()
+	last := chainAnchor{Seq: l.seq, Hash: l.lastHash}
+	anchorBroken := l.anchorBroken
+	l.mu.Unlock()
+	if err != nil {
+		return nil, false, err
+	}
+	stored, err := snap.read()
+	if err != nil {
+		return nil, false, err
+	}
+	chainIntact = verifyChain(ctx, l.key, stored) && verifyAnchor(stored, &last) && !anchorBroken
+	for _, e := range stored {
+		if e.Time.Before(opts.Since) ||
+			(opts.Username != "" && e.Username != opts.Username) ||
+			(opts.Method != "" && e.Method != opts.Method) ||
+			(opts.Event != "" && e.Event != opts.Event) {
+			continue
+		}
+		events = append(events, e)
+	}
+	if opts.Limit > 0 && len(events) > opts.Limit {
+		events = events[len(events)-opts.Limit:]
+	}
+	return events, chainIntact, nil
+}
+
+// verifyChain checks event hashes and links between consecutive events.
+// The first event is trusted to link to events from removed rotated files, removal of the newest events is checked with anchor.
+func verifyChain(ctx context.Context, key []byte, events []Event) bool {
+	for i, e := range events {
+		hash, err := e.computeHash(key)
+		if err != nil || hash != e.Hash || (i > 0 && (e.PrevHash != events[i-1].Hash || e.Seq != events[i-1].Seq+1)) {
+			zerolog.Ctx(ctx).Warn().Uint64("seq", e.Seq).Msg("audit log hash chain is broken")
+			return false
+		}
+	}
+	return true
+}
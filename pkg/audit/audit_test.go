@@ -0,0 +1,278 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/log"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+type memKV map[string][]byte
+
+func (m memKV) Get(_ context.Context, key string) ([]byte, error) {
+	val, ok := m[key]
+	if !ok {
+		return nil, types.ErrNotFound
+	}
+	return val, nil
+}
+
+func (m memKV) Set(_ context.Context, key string, val []byte) error {
+	m[key] = val
+	return nil
+}
+
+func newFileLog(t *testing.T, kv memKV, path string, maxBackups int) *Log {
+	t.Helper()
+	l, err := newLog(context.Background(), Config{Enabled: true, Sink: SinkFile, File: FileConfig{Path: path, MaxSizeMB: 1, MaxBackups: maxBackups}}, kv)
+	require.NoError(t, err)
+	return l
+}
+
+func Test_Log_hashChain(t *testing.T) {
+	r := require.New(t)
+	ctx := xctx.SetUsername(context.Background(), "admin")
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	kv := memKV{}
+
+	l := newFileLog(t, kv, path, 1)
+	l.Record(ctx, Event{Event: EventRPC, Method: "/ceph.Users/CreateUser", Resources: map[string]string{"username": "bob"}, Code: "OK"})
+	l.Record(ctx, Event{Event: "login_failed", Username: "bob", Code: "Unauthenticated"})
+	r.NoError(l.Close())
+
+	// chain is continued after restart
+	l = newFileLog(t, kv, path, 1)
+	l.Record(ctx, Event{Event: EventRPC, Method: "/ceph.Users/DeleteUser", Code: "OK"})
+	events, intact, err := l.List(ctx, ListOpts{})
+	r.NoError(err)
+	r.True(intact)
+	r.Len(events, 3)
+	for i, e := range events {
+		r.EqualValues(i+1, e.Seq)
+		if i > 0 {
+			r.Equal(events[i-1].Hash, e.PrevHash)
+		}
+	}
+	r.Equal("admin", events[0].Username)
+	r.Equal("bob", events[1].Username, "username from event is not overridden")
+
+	events, _, err = l.List(ctx, ListOpts{Username: "admin", Limit: 1})
+	r.NoError(err)
+	r.Len(events, 1)
+	r.Equal("/ceph.Users/DeleteUser", events[0].Method)
+	events, _, err = l.List(ctx, ListOpts{Since: time.Now().Add(time.Hour)})
+	r.NoError(err)
+	r.Empty(events)
+	r.NoError(l.Close())
+
+	// modified event is detected
+	data, err := os.ReadFile(path)
+	r.NoError(err)
+	r.NoError(os.WriteFile(path, bytes.Replace(data, []byte(`"username":"bob"`), []byte(`"username":"eve"`), 1), 0o600))
+	l = newFileLog(t, kv, path, 1)
+	_, intact, err = l.List(ctx, ListOpts{})
+	r.NoError(err)
+	r.False(intact)
+	r.NoError(l.Close())
+
+	// event modified with recomputed hashes is detected without HMAC key
+	r.NoError(os.WriteFile(path, data, 0o600))
+	events, err = readEvents(bytes.NewReader(data))
+	r.NoError(err)
+	events[1].Username = "eve"
+	var forged bytes.Buffer
+	for i := range events {
+		if i > 0 {
+			events[i].PrevHash = events[i-1].Hash
+		}
+		events[i].Hash, err = events[i].computeHash([]byte("guessed key"))
+		r.NoError(err)
+		line, err := json.Marshal(&events[i])
+		r.NoError(err)
+		forged.Write(append(line, '\n'))
+	}
+	r.NoError(os.WriteFile(path, forged.Bytes(), 0o600))
+	l = newFileLog(t, kv, path, 1)
+	_, intact, err = l.List(ctx, ListOpts{})
+	r.NoError(err)
+	r.False(intact)
+	r.NoError(l.Close())
+
+	// removed event is detected
+	lines := strings.SplitAfter(string(data), "\n")
+	r.NoError(os.WriteFile(path, []byte(lines[0]+lines[2]), 0o600))
+	l = newFileLog(t, kv, path, 1)
+	_, intact, err = l.List(ctx, ListOpts{})
+	r.NoError(err)
+	r.False(intact)
+	r.NoError(l.Close())
+}
+
+func Test_Log_removedNewestEvents(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit.log")
+	kv := memKV{}
+
+	l := newFileLog(t, kv, path, 1)
+	for i := 0; i < 3; i++ {
+		l.Record(ctx, Event{Event: EventRPC, Code: "OK"})
+	}
+	data, err := os.ReadFile(path)
+	r.NoError(err)
+	lines := strings.SplitAfter(string(data), "\n")
+
+	// removed while running
+	r.NoError(os.WriteFile(path, []byte(lines[0]+lines[1]), 0o600))
+	_, intact, err := l.List(ctx, ListOpts{})
+	r.NoError(err)
+	r.False(intact)
+	r.NoError(l.Close())
+
+	// removed while stopped
+	l = newFileLog(t, kv, path, 1)
+	events, intact, err := l.List(ctx, ListOpts{})
+	r.NoError(err)
+	r.False(intact)
+	r.Equal(EventChainBroken, events[len(events)-1].Event)
+	r.NoError(l.Close())
+
+	// chain broken event is kept after restart
+	l = newFileLog(t, kv, path, 1)
+	events, _, err = l.List(ctx, ListOpts{Event: EventChainBroken})
+	r.NoError(err)
+	r.Len(events, 1)
+	r.NoError(l.Close())
+}
+
+// countingKV counts writes of memKV.
+type countingKV struct {
+	mu   sync.Mutex
+	kv   memKV
+	sets int
+}
+
+func (c *countingKV) Get(ctx context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.kv.Get(ctx, key)
+}
+
+func (c *countingKV) Set(ctx context.Context, key string, val []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sets++
+	return c.kv.Set(ctx, key, val)
+}
+
+func Test_anchorStore(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	kv := &countingKV{kv: memKV{}}
+
+	// anchor is written only on close
+	a := newAnchorStore(kv, "id", time.Hour)
+	for i := uint64(1); i <= 100; i++ {
+		a.set(chainAnchor{Seq: i, Hash: "h"})
+	}
+	a.close()
+	r.Equal(1, kv.sets)
+	anchor, err := a.load(ctx)
+	r.NoError(err)
+	r.EqualValues(100, anchor.Seq)
+
+	// the latest anchor is written every interval
+	a = newAnchorStore(kv, "id", 10*time.Millisecond)
+	defer a.close()
+	a.set(chainAnchor{Seq: 101, Hash: "h"})
+	r.Eventually(func() bool {
+		anchor, err := a.load(ctx)
+		return err == nil && anchor.Seq == 101
+	}, time.Second, 5*time.Millisecond)
+}
+
+func Test_Log_rotation(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l := newFileLog(t, memKV{}, path, 2)
+	reason := strings.Repeat("x", 200<<10)
+	for i := 0; i < 20; i++ {
+		l.Record(ctx, Event{Event: EventRPC, Reason: reason, Code: "OK"})
+	}
+	r.FileExists(path + ".1")
+	r.FileExists(path + ".2")
+	r.NoFileExists(path + ".3")
+
+	// list does not block and is not affected by concurrent writes and rotation
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			l.Record(ctx, Event{Event: EventRPC, Reason: reason, Code: "OK"})
+		}
+	}()
+	for i := 0; i < 5; i++ {
+		_, intact, err := l.List(ctx, ListOpts{})
+		r.NoError(err)
+		r.True(intact)
+	}
+	<-done
+
+	events, intact, err := l.List(ctx, ListOpts{})
+	r.NoError(err)
+	r.True(intact, "chain is verified across rotated files")
+	r.Less(len(events), 30, "events from removed files are not returned")
+	r.EqualValues(30, events[len(events)-1].Seq)
+	r.NoError(l.Close())
+}
+
+func Test_Log_disabled(t *testing.T) {
+	r := require.New(t)
+	l, err := New(context.Background(), Config{}, nil)
+	r.NoError(err)
+	r.Nil(l)
+	l.Record(context.Background(), Event{Event: EventRPC})
+	_, _, err = l.List(context.Background(), ListOpts{})
+	r.ErrorIs(err, types.ErrNotImplemented)
+	r.NoError(l.Close())
+
+	_, err = New(context.Background(), Config{Enabled: true, Sink: "kafka"}, nil)
+	r.ErrorIs(err, types.ErrInvalidConfig)
+	_, err = New(context.Background(), Config{Enabled: true, Sink: SinkFile}, nil)
+	r.ErrorIs(err, types.ErrInvalidConfig)
+	_, err = New(context.Background(), Config{Enabled: true, Sink: SinkStdout}, nil)
+	r.ErrorIs(err, types.ErrInvalidConfig, "key file is required without rados")
+}
+
+func Test_RedactRequest(t *testing.T) {
+	r := require.New(t)
+	req := &pb.CreateUserReq{Username: "bob", Password: "secret-pass", Roles: []string{"administrator"}}
+	data := RedactRequest(req)
+	r.NotContains(string(data), "secret-pass")
+	var res map[string]any
+	r.NoError(json.Unmarshal(data, &res))
+	r.Equal(log.Redacted, res["password"])
+	r.Equal("bob", res["username"])
+	r.Equal("secret-pass", req.Password, "original request is not modified")
+	r.Equal(map[string]string{"username": "bob"}, RequestResources(req))
+
+	data = RedactRequest(&pb.CreateClusterUserReq{UserEntity: "client.a", ImportData: []byte("[client.a]\nkey = AQD...")})
+	r.NotContains(string(data), "AQD")
+	data = RedactRequest(&pb.ImportAccessDBReq{Data: `{"users":{"bob":{"password":"$2b$12$hash"}}}`})
+	r.NotContains(string(data), "hash")
+	r.Equal(map[string]string{"client.client_id": "app"}, RequestResources(&pb.UpdateOAuthClientReq{Client: &pb.OAuthClient{ClientId: "app"}}))
+	r.Equal(map[string]string{"entities": "client.a,client.b"}, RequestResources(&pb.ExportClusterUserReq{Entities: []string{"client.a", "client.b"}}))
+	r.Nil(RedactRequest(nil))
+}
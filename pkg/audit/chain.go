@@ -0,0 +1,197 @@
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	goceph "github.com/ceph/go-ceph/rados"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// keyKey - config-key with generated HMAC key of hash chain, if audit.keyFile is not set.
+	keyKey = "ceph-api/audit/key"
+	// anchorKeyPrefix - config-key prefix with the last event of audit file written by API replica.
+	anchorKeyPrefix = "ceph-api/audit/anchor/"
+	minKeyLen       = 32
+	// anchorFlushInterval - how often the last event is stored as chain anchor. Events written after the last flush
+	// can be removed undetected if API is killed before Close.
+	anchorFlushInterval = 10 * time.Second
+	// EventChainBroken - written on start if the newest events were removed or modified while API was stopped.
+	EventChainBroken = "audit_chain_broken"
+)
+
+// kvStore - storage of HMAC key and chain anchor outside of audit file. Get returns types.ErrNotFound for missing keys.
+type kvStore interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, val []byte) error
+}
+
+// radosKV stores values in Ceph config-key store.
+type radosKV struct {
+	radosSvc *rados.Svc
+}
+
+func (r *radosKV) Get(ctx context.Context, key string) ([]byte, error) {
+	cmd, err := rados.MonCmd{Prefix: "config-key get", Args: map[string]any{"key": key}}.Build()
+	if err != nil {
+		return nil, err
+	}
+	res, err := r.radosSvc.ExecMon(ctx, cmd)
+	if errors.Is(err, goceph.ErrNotFound) {
+		return nil, fmt.Errorf("%w: config-key %s", types.ErrNotFound, key)
+	}
+	return res, err
+}
+
+func (r *radosKV) Set(ctx context.Context, key string, val []byte) error {
+	cmd, err := rados.MonCmd{Prefix: "config-key set", Args: map[string]any{"key": key}}.Build()
+	if err != nil {
+		return err
+	}
+	_, err = r.radosSvc.ExecMonWithInputBuff(ctx, cmd, val)
+	return err
+}
+
+// loadKey reads HMAC key of hash chain from configured file or from kv store.
+// If key is not configured and not stored yet, a new key is generated and stored.
+func loadKey(ctx context.Context, conf Config, kv kvStore) ([]byte, error) {
+	if conf.KeyFile != "" {
+		key, err := os.ReadFile(conf.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w: unable to read audit key file", err)
+		}
+		if len(key) < minKeyLen {
+			return nil, fmt.Errorf("%w: audit key should be at least %d bytes", types.ErrInvalidConfig, minKeyLen)
+		}
+		return key, nil
+	}
+	if kv == nil {
+		return nil, fmt.Errorf("%w: audit.keyFile is required", types.ErrInvalidConfig)
+	}
+	key, err := kv.Get(ctx, keyKey)
+	if err == nil {
+		return key, nil
+	}
+	if !errors.Is(err, types.ErrNotFound) {
+		return nil, err
+	}
+	key = make([]byte, minKeyLen)
+	if _, err = rand.Read(key); err != nil {
+		return nil, err
+	}
+	if err = kv.Set(ctx, keyKey, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// chainAnchor - sequence number and hash of the last written event.
+type chainAnchor struct {
+	Seq  uint64 `json:"seq"`
+	Hash string `json:"hash"`
+}
+
+// anchorStore keeps the last event of audit file in kv store, so removal of the newest events
+// is detected after restart. Anchor is stored in background every interval and on close, only the latest one is written.
+type anchorStore struct {
+	kv       kvStore
+	key      string
+	interval time.Duration
+	mu       sync.Mutex
+	pending  *chainAnchor
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func newAnchorStore(kv kvStore, id string, interval time.Duration) *anchorStore {
+	res := &anchorStore{
+		kv:       kv,
+		key:      anchorKeyPrefix + id,
+		interval: interval,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go res.run()
+	return res
+}
+
+func (a *anchorStore) load(ctx context.Context) (*chainAnchor, error) {
+	data, err := a.kv.Get(ctx, a.key)
+	if errors.Is(err, types.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var res chainAnchor
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (a *anchorStore) set(anchor chainAnchor) {
+	a.mu.Lock()
+	a.pending = &anchor
+	a.mu.Unlock()
+}
+
+func (a *anchorStore) run() {
+	defer close(a.done)
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.stop:
+			a.flush()
+			return
+		case <-ticker.C:
+			a.flush()
+		}
+	}
+}
+
+func (a *anchorStore) flush() {
+	a.mu.Lock()
+	anchor := a.pending
+	a.pending = nil
+	a.mu.Unlock()
+	if anchor == nil {
+		return
+	}
+	data, err := json.Marshal(anchor)
+	if err == nil {
+		err = a.kv.Set(context.Background(), a.key, data)
+	}
+	if err != nil {
+		zerolog.Ctx(context.Background()).Err(err).Uint64("seq", anchor.Seq).Msg("unable to store audit chain anchor")
+	}
+}
+
+// close writes pending anchor and stops background writer.
+func (a *anchorStore) close() {
+	close(a.stop)
+	<-a.done
+}
+
+// verifyAnchor reports whether events contain anchored event with the same hash.
+func verifyAnchor(events []Event, anchor *chainAnchor) bool {
+	if anchor == nil || anchor.Seq == 0 {
+		return true
+	}
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Seq == anchor.Seq {
+			return events[i].Hash == anchor.Hash
+		}
+	}
+	return false
+}
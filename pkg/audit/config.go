@@ -0,0 +1,57 @@
+package audit
+
+import (
+	"fmt"
+
+	"github.com/clyso/ceph-api/pkg/types"
+)
+
+const (
+	SinkFile   = "file"
+	SinkStdout = "stdout"
+	SinkSyslog = "syslog"
+)
+
+type Config struct {
+	Enabled bool `yaml:"enabled"`
+	// KeyFile - file with HMAC key of hash chain. If empty, key is generated once and stored in Ceph config-key store.
+	KeyFile string `yaml:"keyFile"`
+	// Sink - where audit events are written: "file", "stdout" or "syslog". Events can be listed with API only from file.
+	Sink   string       `yaml:"sink"`
+	File   FileConfig   `yaml:"file"`
+	Syslog SyslogConfig `yaml:"syslog"`
+}
+
+type FileConfig struct {
+	Path string `yaml:"path"`
+	// MaxSizeMB - file is rotated when it reaches given size.
+	MaxSizeMB int `yaml:"maxSizeMB"`
+	// MaxBackups - number of rotated files to keep.
+	MaxBackups int `yaml:"maxBackups"`
+	// AnchorID - unique ID of API replica writing the file. The last event is stored in Ceph config-key
+	// "ceph-api/audit/anchor/<AnchorID>" to detect removal of the newest events. Default: derived from hostname and path.
+	AnchorID string `yaml:"anchorID"`
+}
+
+type SyslogConfig struct {
+	// Network and Address of syslog server, e.g. "udp" and "localhost:514". Local syslog is used if empty.
+	Network string `yaml:"network"`
+	Address string `yaml:"address"`
+	Tag     string `yaml:"tag"`
+}
+
+func (c *Config) validate() error {
+	switch c.Sink {
+	case SinkFile:
+		if c.File.Path == "" {
+			return fmt.Errorf("%w: audit.file.path is required for file sink", types.ErrInvalidConfig)
+		}
+		if c.File.MaxSizeMB <= 0 || c.File.MaxBackups < 0 {
+			return fmt.Errorf("%w: audit.file.maxSizeMB must be positive and audit.file.maxBackups non-negative", types.ErrInvalidConfig)
+		}
+	case SinkStdout, SinkSyslog:
+	default:
+		return fmt.Errorf("%w: unknown audit sink %q, valid values: %+q", types.ErrInvalidConfig, c.Sink, []string{SinkFile, SinkStdout, SinkSyslog})
+	}
+	return nil
+}
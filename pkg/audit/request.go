@@ -0,0 +1,152 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"slices"
+	"strings"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/log"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// resourceFields - request fields identifying affected resources.
+var resourceFields = map[protoreflect.Name]struct{}{
+	"name":        {},
+	"new_name":    {},
+	"username":    {},
+	"client_id":   {},
+	"user_entity": {},
+	"entities":    {},
+}
+
+// sensitiveMessageFields - fields with generic names which hold secrets only in given messages.
+var sensitiveMessageFields = map[protoreflect.FullName][]protoreflect.Name{
+	// access DB backup with password hashes
+	"ceph.ImportAccessDBReq": {"data"},
+}
+
+// RedactRequest returns JSON of request message with secret fields replaced by placeholder.
+func RedactRequest(req any) json.RawMessage {
+	msg, ok := req.(proto.Message)
+	if !ok || msg == nil {
+		return nil
+	}
+	msg = proto.Clone(msg)
+	redactMessage(msg.ProtoReflect())
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+func redactMessage(m protoreflect.Message) {
+	var secrets []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if log.IsSensitiveField(string(fd.Name())) || slices.Contains(sensitiveMessageFields[m.Descriptor().FullName()], fd.Name()) {
+			secrets = append(secrets, fd)
+			return true
+		}
+		if fd.Kind() != protoreflect.MessageKind {
+			return true
+		}
+		switch {
+		case fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				redactMessage(v.List().Get(i).Message())
+			}
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redactMessage(mv.Message())
+					return true
+				})
+			}
+		default:
+			redactMessage(v.Message())
+		}
+		return true
+	})
+	// message is not modified during iteration
+	for _, fd := range secrets {
+		switch {
+		case fd.IsList() || fd.IsMap():
+			m.Clear(fd)
+		case fd.Kind() == protoreflect.StringKind:
+			m.Set(fd, protoreflect.ValueOfString(log.Redacted))
+		case fd.Kind() == protoreflect.BytesKind:
+			m.Set(fd, protoreflect.ValueOfBytes([]byte(log.Redacted)))
+		default:
+			m.Clear(fd)
+		}
+	}
+}
+
+// RequestResources returns resource identifiers set in request or its nested messages, e.g. {"client.client_id": "app"}.
+func RequestResources(req any) map[string]string {
+	msg, ok := req.(proto.Message)
+	if !ok || msg == nil {
+		return nil
+	}
+	res := map[string]string{}
+	collectResources(msg.ProtoReflect(), "", res)
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
+
+func collectResources(m protoreflect.Message, prefix string, res map[string]string) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := prefix + string(fd.Name())
+		switch {
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap():
+			collectResources(v.Message(), name+".", res)
+		case fd.Kind() != protoreflect.StringKind || fd.IsMap():
+		case fd.IsList():
+			if _, ok := resourceFields[fd.Name()]; ok {
+				values := make([]string, v.List().Len())
+				for i := range values {
+					values[i] = v.List().Get(i).String()
+				}
+				res[name] = strings.Join(values, ",")
+			}
+		default:
+			if _, ok := resourceFields[fd.Name()]; ok {
+				res[name] = v.String()
+			}
+		}
+		return true
+	})
+}
+
+// SourceIP returns client IP of http or grpc request.
+func SourceIP(ctx context.Context) string {
+	if ip := xctx.GetSourceIP(ctx); ip != "" {
+		return ip
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	// grpc-gateway calls grpc server over loopback and appends client address to X-Forwarded-For
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if xff := md.Get("x-forwarded-for"); len(xff) != 0 {
+			addrs := strings.Split(xff[len(xff)-1], ",")
+			return strings.TrimSpace(addrs[len(addrs)-1])
+		}
+	}
+	return host
+}
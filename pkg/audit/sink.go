@@ -0,0 +1,205 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/syslog"
+	"os"
+	"path/filepath"
+)
+
+type stdoutSink struct{}
+
+func (stdoutSink) write(line []byte) error {
+	_, err := os.Stdout.Write(line)
+	return err
+}
+
+func (stdoutSink) close() error { return nil }
+
+type syslogSink struct {
+	w *syslog.Writer
+}
+
+func newSyslogSink(conf SyslogConfig) (*syslogSink, error) {
+	w, err := syslog.Dial(conf.Network, conf.Address, syslog.LOG_INFO|syslog.LOG_AUTH, conf.Tag)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to connect to syslog", err)
+	}
+	return &syslogSink{w: w}, nil
+}
+
+func (s *syslogSink) write(line []byte) error {
+	_, err := s.w.Write(line)
+	return err
+}
+
+func (s *syslogSink) close() error {
+	return s.w.Close()
+}
+
+// fileSink writes events as JSON lines and rotates file to <path>.1, <path>.2, ... when it reaches max size.
+type fileSink struct {
+	conf FileConfig
+	f    *os.File
+	size int64
+}
+
+// openFileSink opens audit file for append and returns stored events to continue hash chain.
+func openFileSink(conf FileConfig) (*fileSink, []Event, error) {
+	if err := os.MkdirAll(filepath.Dir(conf.Path), 0o750); err != nil {
+		return nil, nil, err
+	}
+	res := &fileSink{conf: conf}
+	if err := res.open(); err != nil {
+		return nil, nil, err
+	}
+	stored, err := res.readAll()
+	if err != nil {
+		res.f.Close()
+		return nil, nil, err
+	}
+	return res, stored, nil
+}
+
+func (s *fileSink) open() error {
+	f, err := os.OpenFile(s.conf.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("%w: unable to open audit file", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	s.f, s.size = f, info.Size()
+	return nil
+}
+
+func (s *fileSink) backup(i int) string {
+	return fmt.Sprintf("%s.%d", s.conf.Path, i)
+}
+
+func (s *fileSink) write(line []byte) error {
+	if s.size > 0 && s.size+int64(len(line)) > int64(s.conf.MaxSizeMB)<<20 {
+		if err := s.rotate(); err != nil {
+			return fmt.Errorf("%w: unable to rotate audit file", err)
+		}
+	}
+	n, err := s.f.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		return err
+	}
+	return s.f.Sync()
+}
+
+func (s *fileSink) rotate() error {
+	if err := s.f.Close(); err != nil {
+		return err
+	}
+	if err := os.Remove(s.backup(s.conf.MaxBackups)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	for i := s.conf.MaxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(s.backup(i), s.backup(i+1)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	var err error
+	if s.conf.MaxBackups == 0 {
+		err = os.Remove(s.conf.Path)
+	} else {
+		err = os.Rename(s.conf.Path, s.backup(1))
+	}
+	if err != nil {
+		return err
+	}
+	return s.open()
+}
+
+func (s *fileSink) close() error {
+	return s.f.Close()
+}
+
+// readAll returns events from rotated files and current file from the oldest to the newest.
+func (s *fileSink) readAll() ([]Event, error) {
+	snap, err := s.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	return snap.read()
+}
+
+// fileSnapshot - opened audit files, which are read without blocking writer.
+// Rotated files are not changed and current file is read up to the size at the moment of snapshot.
+type fileSnapshot struct {
+	files []*os.File
+	// size - size of the current (the last) file
+	size int64
+}
+
+// snapshot opens rotated and current files from the oldest to the newest. Must be called under lock of writer.
+func (s *fileSink) snapshot() (*fileSnapshot, error) {
+	res := &fileSnapshot{size: s.size}
+	for i := s.conf.MaxBackups; i >= 0; i-- {
+		path := s.conf.Path
+		if i > 0 {
+			path = s.backup(i)
+		}
+		f, err := os.Open(path)
+		if errors.Is(err, fs.ErrNotExist) && i > 0 {
+			continue
+		}
+		if err != nil {
+			res.close()
+			return nil, err
+		}
+		res.files = append(res.files, f)
+	}
+	return res, nil
+}
+
+// read returns events of snapshot files and closes them.
+func (s *fileSnapshot) read() ([]Event, error) {
+	defer s.close()
+	var res []Event
+	for i, f := range s.files {
+		var r io.Reader = f
+		if i == len(s.files)-1 {
+			r = io.LimitReader(f, s.size)
+		}
+		events, err := readEvents(r)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, events...)
+	}
+	return res, nil
+}
+
+func (s *fileSnapshot) close() {
+	for _, f := range s.files {
+		f.Close()
+	}
+}
+
+func readEvents(r io.Reader) ([]Event, error) {
+	var res []Event
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 16<<20)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var e Event
+		// malformed line is kept as empty event to break hash chain
+		_ = json.Unmarshal(scanner.Bytes(), &e)
+		res = append(res, e)
+	}
+	return res, scanner.Err()
+}
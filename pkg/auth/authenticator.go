@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/rs/zerolog"
+)
+
+// Authenticator checks username and password used in OAuth password grant and legacy login.
+// It returns types.ErrUnauthenticated if credentials are not valid, so that the next authenticator can be tried.
+// On success, user must exist in user.Service.
+type Authenticator interface {
+	Authenticate(ctx context.Context, username, password string) error
+}
+
+// localAuthenticator checks password hash of users stored in access DB.
+type localAuthenticator struct {
+	userSvc *user.Service
+}
+
+func (a *localAuthenticator) Authenticate(ctx context.Context, username, password string) error {
+	usr, err := a.userSvc.GetUser(ctx, username)
+	if err != nil {
+		return fmt.Errorf("%w: user not found", types.ErrUnauthenticated)
+	}
+	// password of externally managed user is random
+	if usr.ExternalIssuer != "" {
+		return fmt.Errorf("%w: user is managed by %s", types.ErrUnauthenticated, usr.ExternalIssuer)
+	}
+	if usr.ServiceAccount {
+		return fmt.Errorf("%w: service account can use only API keys", types.ErrUnauthenticated)
+	}
+	if !usr.Enabled {
+		return fmt.Errorf("%w: user disabled", types.ErrUnauthenticated)
+	}
+	return a.userSvc.CheckPassword(ctx, username, password)
+}
+
+// authenticatorChain tries authenticators in order until one of them accepts credentials.
+type authenticatorChain []Authenticator
+
+func (c authenticatorChain) Authenticate(ctx context.Context, username, password string) error {
+	err := fmt.Errorf("%w: no authenticators configured", types.ErrUnauthenticated)
+	for _, a := range c {
+		err = a.Authenticate(ctx, username, password)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, types.ErrUnauthenticated) {
+			zerolog.Ctx(ctx).Err(err).Str("username", username).Msg("authenticator error")
+		}
+	}
+	return err
+}
+
+func newAuthenticator(config Config, userSvc *user.Service) (Authenticator, error) {
+	res := authenticatorChain{&localAuthenticator{userSvc: userSvc}}
+	if config.LDAP.Enabled {
+		ldapAuth, err := newLDAPAuthenticator(config.LDAP, userSvc)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, ldapAuth)
+	}
+	return res, nil
+}
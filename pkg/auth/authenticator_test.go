@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func Test_localAuthenticator_rehash(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	const cost = bcrypt.MinCost + 1
+	userSvc, err := user.New(rados.NewWithConn(&configKeyConn{data: map[string][]byte{}}), nil, user.PasswordPolicy{}, cost)
+	r.NoError(err)
+	authn := &localAuthenticator{userSvc: userSvc}
+
+	salt := []byte("0123456789abcdef")
+	argonHash := fmt.Sprintf("$argon2id$v=19$m=64,t=1,p=1$%s$%s", base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(argon2.IDKey([]byte("secret"), salt, 1, 64, 1, 32)))
+	weakHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	r.NoError(err)
+	r.ErrorIs(userSvc.ImportUser(ctx, user.User{Username: "invalid", Password: "secret", Enabled: true}), types.ErrInvalidArg)
+	r.NoError(userSvc.ImportUser(ctx, user.User{Username: "alice", Password: argonHash, Enabled: true}))
+	r.NoError(userSvc.ImportUser(ctx, user.User{Username: "bob", Password: string(weakHash), Enabled: true}))
+
+	for _, name := range []string{"alice", "bob"} {
+		r.ErrorIs(authn.Authenticate(ctx, name, "wrong"), types.ErrUnauthenticated)
+		usr, err := userSvc.GetUser(ctx, name)
+		r.NoError(err)
+		prevHash := usr.Password
+
+		r.NoError(authn.Authenticate(ctx, name, "secret"))
+		usr, err = userSvc.GetUser(ctx, name)
+		r.NoError(err)
+		r.NotEqualValues(prevHash, usr.Password, "password rehashed")
+		hashCost, err := bcrypt.Cost([]byte(usr.Password))
+		r.NoError(err)
+		r.EqualValues(cost, hashCost)
+		r.NoError(authn.Authenticate(ctx, name, "secret"))
+	}
+
+	_, err = user.New(rados.NewWithConn(&configKeyConn{data: map[string][]byte{}}), nil, user.PasswordPolicy{}, 3)
+	r.ErrorIs(err, types.ErrInvalidArg)
+}
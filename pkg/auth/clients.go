@@ -0,0 +1,358 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/ory/fosite"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	clientsKey = "ceph-api/oauth/clients"
+	// clientReloadInterval - how often clients changed by other API replicas are reloaded.
+	clientReloadInterval = 10 * time.Second
+
+	GrantAuthorizationCode = "authorization_code"
+	GrantRefreshToken      = "refresh_token"
+	GrantPassword          = "password"
+	GrantClientCredentials = "client_credentials"
+)
+
+var supportedGrants = []string{GrantAuthorizationCode, GrantRefreshToken, GrantPassword, GrantClientCredentials}
+
+// OAuthClient - registered OAuth 2.0 client. Only client secret hash is stored.
+type OAuthClient struct {
+	ID           string   `json:"id"`
+	Description  string   `json:"description,omitempty"`
+	SecretHash   string   `json:"secretHash,omitempty"`
+	Public       bool     `json:"public"`
+	GrantTypes   []string `json:"grantTypes"`
+	Scopes       []string `json:"scopes"`
+	RedirectURIs []string `json:"redirectURIs,omitempty"`
+	// Roles - ceph-api roles granted to tokens issued with client_credentials grant.
+	Roles     []string `json:"roles,omitempty"`
+	CreatedAt int64    `json:"createdAt"`
+	// Builtin - client from config. It cannot be changed with API.
+	Builtin bool `json:"-"`
+}
+
+func (c *OAuthClient) toFosite() fosite.Client {
+	res := &fosite.DefaultClient{
+		ID:           c.ID,
+		Secret:       []byte(c.SecretHash),
+		RedirectURIs: c.RedirectURIs,
+		GrantTypes:   c.GrantTypes,
+		Scopes:       c.Scopes,
+		Public:       c.Public,
+	}
+	if slices.Contains(c.GrantTypes, GrantAuthorizationCode) {
+		res.ResponseTypes = []string{"code"}
+	}
+	return res
+}
+
+// clientRegistry stores OAuth clients in kvStore shared by API replicas.
+type clientRegistry struct {
+	kv      kvStore
+	builtin OAuthClient
+	// builtinClient - client from config as it was registered before client registry
+	builtinClient fosite.Client
+	userSvc       *user.Service
+
+	mu       sync.Mutex
+	clients  map[string]OAuthClient
+	loadedAt time.Time
+}
+
+func newClientRegistry(kv kvStore, builtin *fosite.DefaultClient, userSvc *user.Service) *clientRegistry {
+	return &clientRegistry{
+		kv: kv,
+		builtin: OAuthClient{
+			ID:         builtin.ID,
+			Public:     builtin.Public,
+			GrantTypes: builtin.GrantTypes,
+			Scopes:     builtin.Scopes,
+			Builtin:    true,
+		},
+		builtinClient: builtin,
+		userSvc:       userSvc,
+	}
+}
+
+// load returns clients from kvStore. Must be called under lock.
+func (r *clientRegistry) load(ctx context.Context, force bool) (map[string]OAuthClient, error) {
+	if !force && r.clients != nil && time.Since(r.loadedAt) < clientReloadInterval {
+		return r.clients, nil
+	}
+	res := map[string]OAuthClient{}
+	val, err := r.kv.Get(ctx, clientsKey)
+	switch {
+	case errors.Is(err, types.ErrNotFound):
+	case err != nil:
+		return nil, err
+	default:
+		if err = json.Unmarshal(val, &res); err != nil {
+			return nil, err
+		}
+	}
+	r.clients, r.loadedAt = res, time.Now()
+	return res, nil
+}
+
+// store writes clients to kvStore. Must be called under lock.
+func (r *clientRegistry) store(ctx context.Context, clients map[string]OAuthClient) error {
+	val, err := json.Marshal(clients)
+	if err != nil {
+		return err
+	}
+	if err = r.kv.Set(ctx, clientsKey, val); err != nil {
+		return err
+	}
+	// detect concurrent write of other API replica
+	stored, err := r.kv.Get(ctx, clientsKey)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(stored, val) {
+		r.clients = nil
+		return fmt.Errorf("%w: oauth clients were overwritten concurrently", types.ErrAborted)
+	}
+	r.clients, r.loadedAt = clients, time.Now()
+	return nil
+}
+
+func (r *clientRegistry) get(ctx context.Context, id string) (OAuthClient, error) {
+	if id == r.builtin.ID {
+		return r.builtin, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clients, err := r.load(ctx, false)
+	if err != nil {
+		return OAuthClient{}, err
+	}
+	res, ok := clients[id]
+	if !ok {
+		return OAuthClient{}, fmt.Errorf("%w: oauth client %s", types.ErrNotFound, id)
+	}
+	return res, nil
+}
+
+// GetClient implements fosite.ClientManager.
+func (r *clientRegistry) GetClient(ctx context.Context, id string) (fosite.Client, error) {
+	if id == r.builtin.ID {
+		return r.builtinClient, nil
+	}
+	res, err := r.get(ctx, id)
+	if errors.Is(err, types.ErrNotFound) {
+		return nil, fosite.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res.toFosite(), nil
+}
+
+func (r *clientRegistry) list(ctx context.Context) ([]OAuthClient, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clients, err := r.load(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]OAuthClient, 0, len(clients)+1)
+	res = append(res, r.builtin)
+	for _, c := range clients {
+		res = append(res, c)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ID < res[j].ID
+	})
+	return res, nil
+}
+
+// create registers client and returns generated secret for confidential client.
+func (r *clientRegistry) create(ctx context.Context, client OAuthClient) (string, error) {
+	if client.ID == r.builtin.ID {
+		return "", fmt.Errorf("%w: oauth client %s", types.ErrAlreadyExists, client.ID)
+	}
+	if err := r.validate(ctx, &client); err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clients, err := r.load(ctx, true)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := clients[client.ID]; ok {
+		return "", fmt.Errorf("%w: oauth client %s", types.ErrAlreadyExists, client.ID)
+	}
+	var secret string
+	if !client.Public {
+		if secret, client.SecretHash, err = newClientSecret(); err != nil {
+			return "", err
+		}
+	}
+	client.CreatedAt = time.Now().Unix()
+	clients = cloneClients(clients)
+	clients[client.ID] = client
+	return secret, r.store(ctx, clients)
+}
+
+// update changes client settings. New secret is generated and returned if requested or if client became confidential.
+func (r *clientRegistry) update(ctx context.Context, client OAuthClient, regenerateSecret bool) (string, error) {
+	if client.ID == r.builtin.ID {
+		return "", fmt.Errorf("%w: oauth client %s is defined in config", types.ErrInvalidArg, client.ID)
+	}
+	if err := r.validate(ctx, &client); err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clients, err := r.load(ctx, true)
+	if err != nil {
+		return "", err
+	}
+	prev, ok := clients[client.ID]
+	if !ok {
+		return "", fmt.Errorf("%w: oauth client %s", types.ErrNotFound, client.ID)
+	}
+	client.CreatedAt = prev.CreatedAt
+	client.SecretHash = prev.SecretHash
+	var secret string
+	switch {
+	case client.Public:
+		client.SecretHash = ""
+	case regenerateSecret || client.SecretHash == "":
+		if secret, client.SecretHash, err = newClientSecret(); err != nil {
+			return "", err
+		}
+	}
+	clients = cloneClients(clients)
+	clients[client.ID] = client
+	return secret, r.store(ctx, clients)
+}
+
+func (r *clientRegistry) delete(ctx context.Context, id string) error {
+	if id == r.builtin.ID {
+		return fmt.Errorf("%w: oauth client %s is defined in config", types.ErrInvalidArg, id)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clients, err := r.load(ctx, true)
+	if err != nil {
+		return err
+	}
+	if _, ok := clients[id]; !ok {
+		return fmt.Errorf("%w: oauth client %s", types.ErrNotFound, id)
+	}
+	clients = cloneClients(clients)
+	delete(clients, id)
+	return r.store(ctx, clients)
+}
+
+func (r *clientRegistry) validate(ctx context.Context, c *OAuthClient) error {
+	if c.ID == "" {
+		return fmt.Errorf("%w: oauth client id required", types.ErrInvalidArg)
+	}
+	if len(c.GrantTypes) == 0 {
+		return fmt.Errorf("%w: oauth client grant types required", types.ErrInvalidArg)
+	}
+	for _, g := range c.GrantTypes {
+		if !slices.Contains(supportedGrants, g) {
+			return fmt.Errorf("%w: unsupported grant type %q, valid values: %+q", types.ErrInvalidArg, g, supportedGrants)
+		}
+	}
+	if len(c.Scopes) == 0 {
+		c.Scopes = []string{"openid", "offline"}
+	}
+	for _, uri := range c.RedirectURIs {
+		if u, err := url.Parse(uri); err != nil || !u.IsAbs() {
+			return fmt.Errorf("%w: redirect uri %q must be absolute url", types.ErrInvalidArg, uri)
+		}
+	}
+	if slices.Contains(c.GrantTypes, GrantAuthorizationCode) && len(c.RedirectURIs) == 0 {
+		return fmt.Errorf("%w: redirect uris required for %s grant", types.ErrInvalidArg, GrantAuthorizationCode)
+	}
+	if slices.Contains(c.GrantTypes, GrantClientCredentials) {
+		if c.Public {
+			return fmt.Errorf("%w: public client cannot use %s grant", types.ErrInvalidArg, GrantClientCredentials)
+		}
+		if len(c.Roles) == 0 {
+			return fmt.Errorf("%w: roles required for %s grant", types.ErrInvalidArg, GrantClientCredentials)
+		}
+	}
+	for _, role := range c.Roles {
+		if _, err := r.userSvc.GetRole(ctx, role); err != nil {
+			return fmt.Errorf("%w: role %s not found", types.ErrInvalidArg, role)
+		}
+	}
+	return nil
+}
+
+func newClientSecret() (secret, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err = rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	secret = hex.EncodeToString(buf)
+	// fosite compares client secrets with bcrypt
+	hashed, err := bcrypt.GenerateFromPassword([]byte(secret), bcrypt.DefaultCost)
+	if err != nil {
+		return "", "", err
+	}
+	return secret, string(hashed), nil
+}
+
+func cloneClients(clients map[string]OAuthClient) map[string]OAuthClient {
+	res := make(map[string]OAuthClient, len(clients)+1)
+	for k, v := range clients {
+		res[k] = v
+	}
+	return res
+}
+
+func (s *Server) ListClients(ctx context.Context) ([]OAuthClient, error) {
+	return s.clients.list(ctx)
+}
+
+func (s *Server) GetClient(ctx context.Context, id string) (OAuthClient, error) {
+	return s.clients.get(ctx, id)
+}
+
+// CreateClient registers OAuth client. Returns generated secret for confidential client. Secret cannot be retrieved later.
+func (s *Server) CreateClient(ctx context.Context, client OAuthClient) (string, error) {
+	return s.clients.create(ctx, client)
+}
+
+// UpdateClient changes OAuth client settings. Returns new secret if it was regenerated.
+func (s *Server) UpdateClient(ctx context.Context, client OAuthClient, regenerateSecret bool) (string, error) {
+	return s.clients.update(ctx, client, regenerateSecret)
+}
+
+func (s *Server) DeleteClient(ctx context.Context, id string) error {
+	return s.clients.delete(ctx, id)
+}
+
+// ClientRoles returns roles granted to client_credentials tokens of given client.
+func (s *Server) ClientRoles(ctx context.Context, clientID string) ([]string, error) {
+	client, err := s.clients.get(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	return client.Roles, nil
+}
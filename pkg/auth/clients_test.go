@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/ory/fosite"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type methodStream struct {
+	grpc.ServerTransportStream
+	method string
+}
+
+func (s *methodStream) Method() string { return s.method }
+
+// grpcCtx returns context of grpc request with given bearer token.
+func grpcCtx(token string) context.Context {
+	return grpcMethodCtx(token, "/ceph.Status/GetCephStatus")
+}
+
+func grpcMethodCtx(token, method string) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	return grpc.NewContextWithServerTransportStream(ctx, &methodStream{method: method})
+}
+
+func newTestServer(t *testing.T, userSvc *user.Service) *Server {
+	srv, err := NewServer(Config{
+		AccessTokenLifespan:  time.Minute,
+		RefreshTokenLifespan: time.Hour,
+		ClientID:             "ceph-api",
+		Issuer:               "ceph-api",
+		TokenStore:           TokenStoreMemory,
+	}, userSvc, rados.NewWithConn(&configKeyConn{data: map[string][]byte{}}), nil)
+	require.NoError(t, err)
+	return srv
+}
+
+func requestToken(srv *Server, form url.Values, clientID, secret string) (int, string) {
+	req := httptest.NewRequest(http.MethodPost, "/api/oauth/token", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(secret))
+	rec := httptest.NewRecorder()
+	srv.TokenEndpoint(rec, req)
+	var res struct {
+		AccessToken string `json:"access_token"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &res)
+	return rec.Code, res.AccessToken
+}
+
+func Test_ClientCredentials(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	userSvc := newTestUserSvc(t)
+	srv := newTestServer(t, userSvc)
+	authFn := AuthFunc(userSvc, srv.Provider(), srv.GetPublicKey, srv.ClientRoles, nil, nil)
+	m2m := url.Values{"grant_type": {GrantClientCredentials}}
+
+	// invalid clients
+	_, err := srv.CreateClient(ctx, OAuthClient{ID: "ci", GrantTypes: []string{GrantClientCredentials}})
+	r.ErrorIs(err, types.ErrInvalidArg, "roles required")
+	_, err = srv.CreateClient(ctx, OAuthClient{ID: "ci", Public: true, GrantTypes: []string{GrantClientCredentials}, Roles: []string{"read-only"}})
+	r.ErrorIs(err, types.ErrInvalidArg, "public client")
+	_, err = srv.CreateClient(ctx, OAuthClient{ID: "web", Public: true, GrantTypes: []string{GrantAuthorizationCode}})
+	r.ErrorIs(err, types.ErrInvalidArg, "redirect uri required")
+	_, err = srv.CreateClient(ctx, OAuthClient{ID: "ceph-api", GrantTypes: []string{GrantPassword}})
+	r.ErrorIs(err, types.ErrAlreadyExists)
+
+	secret, err := srv.CreateClient(ctx, OAuthClient{ID: "ci", GrantTypes: []string{GrantClientCredentials}, Roles: []string{"read-only"}})
+	r.NoError(err)
+	r.NotEmpty(secret)
+	clients, err := srv.ListClients(ctx)
+	r.NoError(err)
+	r.Len(clients, 2)
+	r.True(clients[0].Builtin)
+	r.EqualValues("ci", clients[1].ID)
+
+	// client gets token with its own permissions
+	code, token := requestToken(srv, m2m, "ci", secret)
+	r.EqualValues(http.StatusOK, code)
+	r.NotEmpty(token)
+	authCtx, err := authFn(grpcCtx(token))
+	r.NoError(err)
+	r.NotEmpty(xctx.GetPermissions(authCtx))
+	r.EqualValues([]string{"read-only"}, xctx.GetRoles(authCtx))
+	r.WithinDuration(time.Now().Add(time.Minute), xctx.GetExpiresAt(authCtx), 5*time.Second)
+	r.NoError(user.HasPermissions(authCtx, user.ScopePool, user.PermRead))
+	r.ErrorIs(user.HasPermissions(authCtx, user.ScopePool, user.PermCreate), types.ErrAccessDenied)
+
+	code, _ = requestToken(srv, m2m, "ci", "wrong")
+	r.EqualValues(http.StatusUnauthorized, code)
+	code, _ = requestToken(srv, m2m, "ceph-api", "")
+	r.NotEqualValues(http.StatusOK, code, "builtin public client cannot use client credentials")
+
+	// secret rotation
+	newSecret, err := srv.UpdateClient(ctx, OAuthClient{ID: "ci", GrantTypes: []string{GrantClientCredentials}, Roles: []string{"administrator"}}, true)
+	r.NoError(err)
+	r.NotEqualValues(secret, newSecret)
+	code, _ = requestToken(srv, m2m, "ci", secret)
+	r.EqualValues(http.StatusUnauthorized, code)
+	// roles change applies to issued tokens
+	authCtx, err = authFn(grpcCtx(token))
+	r.NoError(err)
+	r.NoError(user.HasPermissions(authCtx, user.ScopePool, user.PermCreate))
+
+	// tokens of deleted client are rejected
+	r.NoError(srv.DeleteClient(ctx, "ci"))
+	_, err = authFn(grpcCtx(token))
+	r.Error(err)
+	r.Error(srv.DeleteClient(ctx, "ceph-api"))
+}
+
+// racingKV overwrites value with write of other replica right after Set.
+type racingKV struct {
+	*memKV
+	other []byte
+}
+
+func (k *racingKV) Set(ctx context.Context, key string, val []byte) error {
+	if err := k.memKV.Set(ctx, key, val); err != nil {
+		return err
+	}
+	if k.other != nil {
+		return k.memKV.Set(ctx, key, k.other)
+	}
+	return nil
+}
+
+func Test_ClientRegistry_concurrentWrite(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	kv := &racingKV{memKV: newMemKV()}
+	reg := newClientRegistry(kv, &fosite.DefaultClient{ID: "ceph-api"}, nil)
+	web := OAuthClient{ID: "web", Public: true, GrantTypes: []string{GrantAuthorizationCode}, RedirectURIs: []string{"https://web"}}
+	_, err := reg.create(ctx, web)
+	r.NoError(err)
+
+	kv.other = []byte(`{"other":{"id":"other","public":true,"grantTypes":["password"],"scopes":["openid"]}}`)
+	_, err = reg.create(ctx, OAuthClient{ID: "cli", Public: true, GrantTypes: []string{GrantPassword}})
+	r.ErrorIs(err, types.ErrAborted)
+	kv.other = nil
+
+	// concurrent write is not hidden by cache
+	_, err = reg.get(ctx, "other")
+	r.NoError(err)
+	_, err = reg.get(ctx, "web")
+	r.ErrorIs(err, types.ErrNotFound)
+}
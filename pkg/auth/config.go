@@ -7,4 +7,110 @@ type Config struct {
 	RefreshTokenLifespan time.Duration `yaml:"refreshTokenLifespan"`
 	ClientID             string        `yaml:"clientID"`
 	Issuer               string        `yaml:"issuer"`
+	// SigningKeyFile - PEM encoded RSA private keys. The last key signs tokens, others are used only for verification.
+	// If empty, keys are generated and stored in Ceph config-key store.
+	SigningKeyFile string `yaml:"signingKeyFile"`
+	// KeyRotationInterval - how often signing key stored in Ceph is replaced with a new one. 0 - disabled.
+	KeyRotationInterval time.Duration `yaml:"keyRotationInterval"`
+	// PublicURL - API URL used in OpenID discovery document. If empty, URL is taken from request.
+	PublicURL string `yaml:"publicURL"`
+	// SecretFile - file with HMAC secret. If empty, secret is generated once and stored in Ceph config-key store.
+	SecretFile string `yaml:"secretFile"`
+	// TokenStore - where to store issued tokens and revocations: "ceph" or "memory".
+	TokenStore string `yaml:"tokenStore"`
+	// TokenCacheTTL - how long list of revoked access tokens is cached by API replica.
+	TokenCacheTTL time.Duration `yaml:"tokenCacheTTL"`
+	// Issuers - trusted external OIDC identity providers.
+	Issuers []IssuerConfig `yaml:"issuers"`
+	// LDAP - authenticate users with LDAP or Active Directory in addition to local users.
+	LDAP LDAPConfig `yaml:"ldap"`
+	// MFA - TOTP multi-factor authentication for password login.
+	MFA MFAConfig `yaml:"mfa"`
+	// Lockout - brute-force protection of password login.
+	Lockout LockoutConfig `yaml:"lockout"`
+}
+
+const (
+	TokenStoreCeph   = "ceph"
+	TokenStoreMemory = "memory"
+)
+
+type IssuerConfig struct {
+	// Issuer - expected "iss" claim of external tokens.
+	Issuer string `yaml:"issuer"`
+	// JWKSURL - issuer public keys URL. If empty, it is taken from <issuer>/.well-known/openid-configuration.
+	JWKSURL string `yaml:"jwksURL"`
+	// Audience - expected "aud" claim. Required.
+	Audience string `yaml:"audience"`
+	// UsernameClaim - claim used as ceph-api username.
+	UsernameClaim string `yaml:"usernameClaim"`
+	// RolesClaim - claim with list of user groups or roles used in RoleMapping.
+	RolesClaim string `yaml:"rolesClaim"`
+	// RoleMapping - maps RolesClaim values to ceph-api roles.
+	RoleMapping []RoleMapping `yaml:"roleMapping"`
+	// PersistUsers - if true, users are created in access DB on the first login and their roles are updated on each login.
+	// Otherwise, permissions are taken from token on each request and users are not stored.
+	PersistUsers bool `yaml:"persistUsers"`
+}
+
+type RoleMapping struct {
+	// Group - group name from token RolesClaim or LDAP group.
+	Group string `yaml:"group"`
+	// Claim - value of RolesClaim. Same as Group, kept for issuer configs written before LDAP support.
+	Claim string `yaml:"claim"`
+	// Roles - ceph-api roles granted to group members.
+	Roles []string `yaml:"roles"`
+}
+
+func (m RoleMapping) group() string {
+	if m.Group != "" {
+		return m.Group
+	}
+	return m.Claim
+}
+
+type LDAPConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// URL - ldap://host:389 or ldaps://host:636.
+	URL                string        `yaml:"url"`
+	StartTLS           bool          `yaml:"startTLS"`
+	InsecureSkipVerify bool          `yaml:"insecureSkipVerify"`
+	Timeout            time.Duration `yaml:"timeout"`
+	// BindDN - service account used to search user DN. If empty, user DN is built with UserDNTemplate.
+	BindDN       string `yaml:"bindDN"`
+	BindPassword string `yaml:"bindPassword"`
+	// UserDNTemplate - user DN with %s placeholder for username, e.g. uid=%s,ou=people,dc=example,dc=com.
+	UserDNTemplate string `yaml:"userDNTemplate"`
+	UserBaseDN     string `yaml:"userBaseDN"`
+	// UserFilter - user search filter with %s placeholder for username.
+	UserFilter  string `yaml:"userFilter"`
+	GroupBaseDN string `yaml:"groupBaseDN"`
+	// GroupFilter - group search filter with %s placeholder for user DN.
+	GroupFilter   string `yaml:"groupFilter"`
+	GroupNameAttr string `yaml:"groupNameAttr"`
+	// RoleMapping - maps LDAP group names to ceph-api roles.
+	RoleMapping []RoleMapping `yaml:"roleMapping"`
+}
+
+type MFAConfig struct {
+	// Issuer - account issuer shown in authenticator apps. Defaults to OAuth issuer.
+	Issuer string `yaml:"issuer"`
+	// RequiredRoles - users with any of these roles cannot log in with password only.
+	RequiredRoles []string `yaml:"requiredRoles"`
+	// KeyFile - file with key encrypting TOTP secrets in access DB. If empty, key is derived from OAuth secret,
+	// so TOTP secrets can be decrypted by anyone who can read both access DB and OAuth secret from config-key store.
+	KeyFile string `yaml:"keyFile"`
+}
+
+type LockoutConfig struct {
+	// Attempts - consecutive failed logins after which user is disabled. 0 - disabled.
+	Attempts int `yaml:"attempts"`
+	// BackoffBase - delay of the next login after failed attempt. Doubled after each next failure.
+	BackoffBase time.Duration `yaml:"backoffBase"`
+	// BackoffMax - max delay of the next login. 0 - no limit.
+	BackoffMax time.Duration `yaml:"backoffMax"`
+	// IPFreeAttempts - failed logins from the same source IP allowed before backoff is applied to IP.
+	IPFreeAttempts int `yaml:"ipFreeAttempts"`
+	// ResetAfter - failures are forgotten after this period without failed logins. 0 - never.
+	ResetAfter time.Duration `yaml:"resetAfter"`
 }
@@ -0,0 +1,222 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/log"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/golang-jwt/jwt"
+	"github.com/rs/zerolog"
+)
+
+// jwksRefreshInterval - min interval between issuer JWKS downloads triggered by unknown key id.
+const jwksRefreshInterval = time.Minute
+
+// ExternalIssuers verifies tokens of external OIDC identity providers.
+type ExternalIssuers struct {
+	issuers map[string]*externalIssuer
+}
+
+func NewExternalIssuers(conf []IssuerConfig) (*ExternalIssuers, error) {
+	res := &ExternalIssuers{issuers: map[string]*externalIssuer{}}
+	for _, c := range conf {
+		if c.Issuer == "" {
+			return nil, fmt.Errorf("%w: external issuer name is empty", types.ErrInvalidConfig)
+		}
+		if c.Audience == "" {
+			// without audience check tokens issued to any other client of the issuer are accepted
+			return nil, fmt.Errorf("%w: external issuer %s audience is empty", types.ErrInvalidConfig, c.Issuer)
+		}
+		if c.UsernameClaim == "" {
+			c.UsernameClaim = "sub"
+		}
+		res.issuers[c.Issuer] = &externalIssuer{conf: c, httpClient: &http.Client{Timeout: 10 * time.Second}}
+	}
+	return res, nil
+}
+
+type externalIssuer struct {
+	conf       IssuerConfig
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	keys      jose.JSONWebKeySet
+	fetchedAt time.Time
+}
+
+// authenticate checks if token was issued by configured external issuer and returns context with user permissions.
+// Returns ok=false if token issuer is not external.
+func (e *ExternalIssuers) authenticate(ctx context.Context, userSvc *user.Service, tokenStr string) (_ context.Context, ok bool, err error) {
+	if e == nil || len(e.issuers) == 0 {
+		return ctx, false, nil
+	}
+	unverified := jwt.MapClaims{}
+	if _, _, err = new(jwt.Parser).ParseUnverified(tokenStr, unverified); err != nil {
+		return ctx, false, nil
+	}
+	iss, _ := unverified["iss"].(string)
+	issuer, ok := e.issuers[iss]
+	if !ok {
+		return ctx, false, nil
+	}
+	ctx, err = issuer.authenticate(ctx, userSvc, tokenStr)
+	return ctx, true, err
+}
+
+func (i *externalIssuer) authenticate(ctx context.Context, userSvc *user.Service, tokenStr string) (context.Context, error) {
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (interface{}, error) {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA, *jwt.SigningMethodRSAPSS:
+		default:
+			return nil, fmt.Errorf("unexpected jwt signing method %v", token.Header["alg"])
+		}
+		kid, _ := token.Header["kid"].(string)
+		return i.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid external token: %v", types.ErrUnauthenticated, err)
+	}
+	if !token.Valid {
+		return nil, fmt.Errorf("%w: invalid external token", types.ErrUnauthenticated)
+	}
+	if !claims.VerifyAudience(i.conf.Audience, true) {
+		return nil, fmt.Errorf("%w: invalid external token audience", types.ErrUnauthenticated)
+	}
+	// jwt parser checks exp only if it is present
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, fmt.Errorf("%w: external token has no expiration time", types.ErrUnauthenticated)
+	}
+	username, _ := claims[i.conf.UsernameClaim].(string)
+	if username == "" {
+		return nil, fmt.Errorf("%w: external token has no %q claim", types.ErrUnauthenticated, i.conf.UsernameClaim)
+	}
+	roles := i.mapRoles(claims)
+	if len(roles) == 0 {
+		return nil, fmt.Errorf("%w: no roles mapped for external user %s", types.ErrAccessDenied, username)
+	}
+
+	var permissions map[string][]string
+	if i.conf.PersistUsers {
+		usr, err := userSvc.EnsureExternalUser(ctx, username, i.conf.Issuer, roles)
+		if err != nil {
+			return nil, err
+		}
+		if !usr.Enabled {
+			return nil, fmt.Errorf("%w: user %s is disabled", types.ErrAccessDenied, username)
+		}
+		permissions = userSvc.GetPermissions(ctx, username)
+	} else {
+		// namespace not persisted users to not act as local user with the same name
+		username = i.conf.Issuer + ":" + username
+		permissions = userSvc.GetRolesPermissions(ctx, roles)
+	}
+	ctx = log.WithUsername(ctx, username)
+	ctx = xctx.SetRoles(ctx, roles)
+	if exp, ok := claims["exp"].(float64); ok {
+		ctx = xctx.SetExpiresAt(ctx, time.Unix(int64(exp), 0))
+	}
+	return xctx.SetPermissions(ctx, permissions), nil
+}
+
+func (i *externalIssuer) mapRoles(claims jwt.MapClaims) []string {
+	var values []string
+	switch v := claims[i.conf.RolesClaim].(type) {
+	case string:
+		values = strings.Fields(v)
+	case []interface{}:
+		for _, val := range v {
+			if str, ok := val.(string); ok {
+				values = append(values, str)
+			}
+		}
+	}
+	return mapGroups(i.conf.RoleMapping, values)
+}
+
+// mapGroups returns sorted roles granted to given groups.
+func mapGroups(mapping []RoleMapping, groups []string) []string {
+	var res []string
+	for _, m := range mapping {
+		if slices.Contains(groups, m.group()) {
+			res = append(res, m.Roles...)
+		}
+	}
+	slices.Sort(res)
+	return slices.Compact(res)
+}
+
+// key returns issuer public key by id. JWKS is downloaded again if key is not found.
+func (i *externalIssuer) key(ctx context.Context, kid string) (interface{}, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if res := i.findKey(kid); res != nil {
+		return res, nil
+	}
+	if time.Since(i.fetchedAt) < jwksRefreshInterval {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	if err := i.fetchKeys(ctx); err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("issuer", i.conf.Issuer).Msg("unable to get external issuer keys")
+		return nil, err
+	}
+	if res := i.findKey(kid); res != nil {
+		return res, nil
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+func (i *externalIssuer) findKey(kid string) interface{} {
+	for _, key := range i.keys.Keys {
+		if (kid == "" || key.KeyID == kid) && key.Use != "enc" {
+			return key.Key
+		}
+	}
+	return nil
+}
+
+func (i *externalIssuer) fetchKeys(ctx context.Context) error {
+	i.fetchedAt = time.Now()
+	jwksURL := i.conf.JWKSURL
+	if jwksURL == "" {
+		var discovery struct {
+			JWKSURI string `json:"jwks_uri"`
+		}
+		if err := i.getJSON(ctx, strings.TrimSuffix(i.conf.Issuer, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+			return err
+		}
+		jwksURL = discovery.JWKSURI
+	}
+	var keys jose.JSONWebKeySet
+	if err := i.getJSON(ctx, jwksURL, &keys); err != nil {
+		return err
+	}
+	i.keys = keys
+	return nil
+}
+
+func (i *externalIssuer) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := i.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
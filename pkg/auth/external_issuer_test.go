@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+type stubIssuer struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+	kid string
+}
+
+func newStubIssuer(t *testing.T) *stubIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	res := &stubIssuer{key: key, kid: "stub-key"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(rw http.ResponseWriter, req *http.Request) {
+		writeJSON(rw, req, map[string]any{"issuer": res.URL, "jwks_uri": res.URL + "/jwks"})
+	})
+	mux.HandleFunc("/jwks", func(rw http.ResponseWriter, req *http.Request) {
+		writeJSON(rw, req, jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &res.key.PublicKey, KeyID: res.kid, Algorithm: string(jose.RS256), Use: "sig"},
+		}})
+	})
+	res.Server = httptest.NewServer(mux)
+	t.Cleanup(res.Close)
+	return res
+}
+
+func (s *stubIssuer) token(t *testing.T, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = s.kid
+	res, err := token.SignedString(s.key)
+	require.NoError(t, err)
+	return res
+}
+
+func Test_ExternalIssuer(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	stub := newStubIssuer(t)
+	userSvc := newTestUserSvc(t)
+
+	conf := IssuerConfig{
+		Issuer:        stub.URL,
+		Audience:      "ceph-api",
+		UsernameClaim: "preferred_username",
+		RolesClaim:    "groups",
+		RoleMapping: []RoleMapping{
+			{Group: "admins", Roles: []string{"administrator"}},
+			// original issuer config key
+			{Claim: "viewers", Roles: []string{"read-only"}},
+		},
+	}
+	claims := func(groups ...string) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":                stub.URL,
+			"aud":                "ceph-api",
+			"sub":                "123",
+			"preferred_username": "alice",
+			"groups":             groups,
+			"exp":                time.Now().Add(time.Minute).Unix(),
+		}
+	}
+
+	noAud := conf
+	noAud.Audience = ""
+	_, err := NewExternalIssuers([]IssuerConfig{noAud})
+	r.ErrorIs(err, types.ErrInvalidConfig, "audience is required")
+
+	issuers, err := NewExternalIssuers([]IssuerConfig{conf})
+	r.NoError(err)
+
+	// not persisted user gets permissions of mapped roles
+	resCtx, ok, err := issuers.authenticate(ctx, userSvc, stub.token(t, claims("viewers", "unknown")))
+	r.True(ok)
+	r.NoError(err)
+	r.NoError(user.HasPermissions(resCtx, user.ScopePool, user.PermRead))
+	r.ErrorIs(user.HasPermissions(resCtx, user.ScopePool, user.PermCreate), types.ErrAccessDenied)
+	r.EqualValues(stub.URL+":alice", xctx.GetUsername(resCtx), "namespaced by issuer")
+	_, err = userSvc.GetUser(ctx, "alice")
+	r.ErrorIs(err, types.ErrNotFound)
+
+	// no mapped roles
+	_, ok, err = issuers.authenticate(ctx, userSvc, stub.token(t, claims("unknown")))
+	r.True(ok)
+	r.ErrorIs(err, types.ErrAccessDenied)
+
+	// expired token, wrong audience and missing claims
+	expired := claims("admins")
+	expired["exp"] = time.Now().Add(-time.Minute).Unix()
+	_, _, err = issuers.authenticate(ctx, userSvc, stub.token(t, expired))
+	r.ErrorIs(err, types.ErrUnauthenticated)
+	wrongAud := claims("admins")
+	wrongAud["aud"] = "other"
+	_, _, err = issuers.authenticate(ctx, userSvc, stub.token(t, wrongAud))
+	r.ErrorIs(err, types.ErrUnauthenticated)
+	noExp := claims("admins")
+	delete(noExp, "exp")
+	_, _, err = issuers.authenticate(ctx, userSvc, stub.token(t, noExp))
+	r.ErrorIs(err, types.ErrUnauthenticated)
+	noAudClaim := claims("admins")
+	delete(noAudClaim, "aud")
+	_, _, err = issuers.authenticate(ctx, userSvc, stub.token(t, noAudClaim))
+	r.ErrorIs(err, types.ErrUnauthenticated)
+
+	// token signed by unknown key
+	other := newStubIssuer(t)
+	other.kid = stub.kid
+	_, _, err = issuers.authenticate(ctx, userSvc, other.token(t, claims("admins")))
+	r.ErrorIs(err, types.ErrUnauthenticated)
+
+	// tokens of other issuers are not handled
+	foreign := claims("admins")
+	foreign["iss"] = other.URL
+	_, ok, _ = issuers.authenticate(ctx, userSvc, other.token(t, foreign))
+	r.False(ok)
+
+	// persisted user is provisioned and its roles are synced
+	conf.PersistUsers = true
+	issuers, err = NewExternalIssuers([]IssuerConfig{conf})
+	r.NoError(err)
+	resCtx, _, err = issuers.authenticate(ctx, userSvc, stub.token(t, claims("admins")))
+	r.NoError(err)
+	r.NoError(user.HasPermissions(resCtx, user.ScopePool, user.PermCreate))
+	usr, err := userSvc.GetUser(ctx, "alice")
+	r.NoError(err)
+	r.EqualValues(stub.URL, usr.ExternalIssuer)
+	r.EqualValues([]string{"administrator"}, usr.Roles)
+
+	_, _, err = issuers.authenticate(ctx, userSvc, stub.token(t, claims("viewers")))
+	r.NoError(err)
+	usr, err = userSvc.GetUser(ctx, "alice")
+	r.NoError(err)
+	r.EqualValues([]string{"read-only"}, usr.Roles)
+}
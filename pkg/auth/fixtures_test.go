@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	goceph "github.com/ceph/go-ceph/rados"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// configKeyConn stores config-key values in memory.
+type configKeyConn struct {
+	mu   sync.Mutex
+	data map[string][]byte
+}
+
+func (c *configKeyConn) MonCommand(args []byte) ([]byte, string, error) {
+	return c.MonCommandWithInputBuffer(args, nil)
+}
+
+func (c *configKeyConn) MonCommandWithInputBuffer(args, inputBuffer []byte) ([]byte, string, error) {
+	var cmd struct {
+		Prefix string `json:"prefix"`
+		Key    string `json:"key"`
+	}
+	if err := json.Unmarshal(args, &cmd); err != nil {
+		return nil, "", err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch cmd.Prefix {
+	case "config-key get":
+		res, ok := c.data[cmd.Key]
+		if !ok {
+			return nil, "", goceph.ErrNotFound
+		}
+		return res, "", nil
+	case "config-key set":
+		c.data[cmd.Key] = inputBuffer
+		return nil, "", nil
+	case "config-key rm":
+		delete(c.data, cmd.Key)
+		return nil, "", nil
+	case "config-key dump":
+		dump := map[string]string{}
+		for k, v := range c.data {
+			if strings.HasPrefix(k, cmd.Key) {
+				dump[k] = string(v)
+			}
+		}
+		res, err := json.Marshal(dump)
+		return res, "", err
+	}
+	return nil, "", goceph.ErrNotFound
+}
+
+func (c *configKeyConn) MgrCommand(args [][]byte) ([]byte, string, error) {
+	return nil, "", goceph.ErrNotFound
+}
+
+func (c *configKeyConn) Shutdown() {}
+
+func newTestUserSvc(t *testing.T) *user.Service {
+	userSvc, err := user.New(rados.NewWithConn(&configKeyConn{data: map[string][]byte{}}), nil, user.PasswordPolicy{}, bcrypt.MinCost)
+	require.NoError(t, err)
+	return userSvc
+}
@@ -3,7 +3,10 @@ package auth
 import (
 	"context"
 	"crypto/rsa"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	xctx "github.com/clyso/ceph-api/pkg/ctx"
 	"github.com/clyso/ceph-api/pkg/log"
@@ -16,26 +19,53 @@ import (
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
-func AuthFunc(userSvc *user.Service, provider fosite.OAuth2Provider, getKey func() *rsa.PublicKey) grpc_auth.AuthFunc {
+func AuthFunc(userSvc *user.Service, provider fosite.OAuth2Provider, getKey func(kid string) (*rsa.PublicKey, error), clientRoles func(ctx context.Context, clientID string) ([]string, error), external *ExternalIssuers, isPublic func(method string) bool) grpc_auth.AuthFunc {
 	return func(ctx context.Context) (context.Context, error) {
 		method, ok := grpc.Method(ctx)
 		if !ok {
 			return nil, fmt.Errorf("%w: unable to extract grpc method from context", types.ErrInternal)
 		}
-		// do not secure auth endpoits
-		switch method {
-		case "/ceph.Auth/Login", "/ceph.Auth/Check":
+		// do not secure auth endpoits declared as public in proto files
+		if isPublic != nil && isPublic(method) {
 			return ctx, nil
 		}
 
+		if apiKey := apiKeyFromMD(ctx); apiKey != "" {
+			usr, key, err := userSvc.AuthenticateAPIKey(ctx, apiKey)
+			if err != nil {
+				zerolog.Ctx(ctx).Err(err).Msg("unable to authenticate API key")
+				return nil, unauthenticated(err)
+			}
+			if key.ExpiresAt != nil {
+				ctx = xctx.SetExpiresAt(ctx, time.Unix(*key.ExpiresAt, 0))
+			}
+			ctx = log.WithUsername(ctx, usr.Username)
+			ctx = xctx.SetRoles(ctx, usr.Roles)
+			return xctx.SetPermissions(ctx, userSvc.GetPermissions(ctx, usr.Username)), nil
+		}
+
 		tokenStr, err := grpc_auth.AuthFromMD(ctx, "bearer")
 		if err != nil {
 			zerolog.Ctx(ctx).Err(err).Msg("unable to extract bearer token from grpc meta")
 			return nil, unauthenticated(fmt.Errorf("no token present: %w", types.ErrUnauthenticated))
 		}
+		if extCtx, ok, err := external.authenticate(ctx, userSvc, tokenStr); ok {
+			if err != nil {
+				zerolog.Ctx(ctx).Err(err).Msg("unable to authenticate external token")
+				switch {
+				case errors.Is(err, types.ErrUnauthenticated):
+					return nil, unauthenticated(err)
+				case errors.Is(err, types.ErrAccessDenied):
+					return nil, status.Error(codes.PermissionDenied, err.Error())
+				}
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			return extCtx, nil
+		}
 		_, ar, err := provider.IntrospectToken(ctx, tokenStr, fosite.AccessToken, new(fosite.DefaultSession))
 		if err != nil {
 			zerolog.Ctx(ctx).Err(err).Msg("unable to introspect token")
@@ -44,7 +74,11 @@ func AuthFunc(userSvc *user.Service, provider fosite.OAuth2Provider, getKey func
 		username := ar.GetSession().GetSubject()
 		claims := jwt.MapClaims{}
 		token, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (interface{}, error) {
-			return getKey(), nil
+			if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+				return nil, fmt.Errorf("unexpected jwt signing method %v", token.Header["alg"])
+			}
+			kid, _ := token.Header["kid"].(string)
+			return getKey(kid)
 		})
 		if err != nil {
 			zerolog.Ctx(ctx).Err(err).Msg("unable to parse jwt")
@@ -58,19 +92,73 @@ func AuthFunc(userSvc *user.Service, provider fosite.OAuth2Provider, getKey func
 			zerolog.Ctx(ctx).Err(err).Msg("unable to validate jwt claims")
 			return nil, unauthenticated(fmt.Errorf("unable to validate jwt claims: %w", types.ErrUnauthenticated))
 		}
+		if exp, ok := claims["exp"].(float64); ok {
+			ctx = xctx.SetExpiresAt(ctx, time.Unix(int64(exp), 0))
+		}
+
+		if ar.GetRequestForm().Get("grant_type") == GrantClientCredentials {
+			clientID := ar.GetClient().GetID()
+			roles, err := clientRoles(ctx, clientID)
+			if err != nil {
+				zerolog.Ctx(ctx).Err(err).Str("client_id", clientID).Msg("oauth client not found")
+				return nil, unauthenticated(types.ErrUnauthenticated)
+			}
+			ctx = log.WithUsername(ctx, "client:"+clientID)
+			ctx = xctx.SetRoles(ctx, roles)
+			return xctx.SetPermissions(ctx, userSvc.GetRolesPermissions(ctx, roles)), nil
+		}
 
 		usr, err := userSvc.GetUser(ctx, username)
 		if err != nil {
 			zerolog.Ctx(ctx).Err(err).Str("username", username).Msg("account not found")
 			return nil, unauthenticated(types.ErrUnauthenticated)
 		}
+		if err = checkUserState(method, usr); err != nil {
+			zerolog.Ctx(ctx).Info().Err(err).Str("username", username).Msg("request rejected by user state")
+			return nil, err
+		}
 		ctx = log.WithUsername(ctx, usr.Username)
+		ctx = xctx.SetRoles(ctx, usr.Roles)
 		ctx = xctx.SetPermissions(ctx, userSvc.GetPermissions(ctx, username))
 
 		return ctx, nil
 	}
 }
 
+// changePasswordMethod - the only method allowed for user who must change password.
+const changePasswordMethod = "/ceph.Users/UserChangePassword"
+
+// checkUserState rejects tokens of disabled users. Users with expired password can only change it.
+func checkUserState(method string, usr user.User) error {
+	if !usr.Enabled {
+		return unauthenticated(fmt.Errorf("%w: user disabled", types.ErrUnauthenticated))
+	}
+	if method != changePasswordMethod && usr.PasswordChangeRequired(time.Now()) {
+		st, err := status.New(codes.FailedPrecondition, types.ErrPwdChangeRequired.Error()).WithDetails(&errdetails.ErrorInfo{
+			Reason: "ErrPwdChangeRequired",
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "build grpc error: %s", err)
+		}
+		return st.Err()
+	}
+	return nil
+}
+
+// APIKeyMetadataKey - grpc metadata key with service account API key. API key can also be sent as bearer token.
+const APIKeyMetadataKey = "x-api-key"
+
+func apiKeyFromMD(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if vals := md.Get(APIKeyMetadataKey); len(vals) != 0 {
+		return vals[0]
+	}
+	if token, err := grpc_auth.AuthFromMD(ctx, "bearer"); err == nil && strings.HasPrefix(token, user.APIKeyPrefix) {
+		return token
+	}
+	return ""
+}
+
 func unauthenticated(err error) error {
 	code := codes.Unauthenticated
 	info := &errdetails.ErrorInfo{
@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_AuthFunc_UserState(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	userSvc := newTestUserSvc(t)
+	srv := newTestServer(t, userSvc)
+	authFn := AuthFunc(userSvc, srv.Provider(), srv.GetPublicKey, srv.ClientRoles, nil, nil)
+	r.NoError(userSvc.CreateUser(ctx, user.User{Username: "alice", Password: "secret", Roles: []string{"read-only"}, Enabled: true}))
+	login := func() (int, string, bool) {
+		form := url.Values{"grant_type": {GrantPassword}, "username": {"alice"}, "password": {"secret"}, "scope": {"offline"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/oauth/token", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth("ceph-api", "")
+		rec := httptest.NewRecorder()
+		srv.TokenEndpoint(rec, req)
+		var res struct {
+			AccessToken       string `json:"access_token"`
+			PwdUpdateRequired bool   `json:"pwd_update_required"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &res)
+		return rec.Code, res.AccessToken, res.PwdUpdateRequired
+	}
+	update := func(fn func(u *user.User)) {
+		usr, err := userSvc.GetUser(ctx, "alice")
+		r.NoError(err)
+		usr.Password = ""
+		fn(&usr)
+		r.NoError(userSvc.UpdateUser(ctx, usr, false))
+	}
+
+	code, token, pwdUpdate := login()
+	r.EqualValues(http.StatusOK, code)
+	r.False(pwdUpdate)
+	_, err := authFn(grpcCtx(token))
+	r.NoError(err)
+
+	// disabled user cannot log in and issued tokens are rejected
+	update(func(u *user.User) { u.Enabled = false })
+	code, _, _ = login()
+	r.NotEqualValues(http.StatusOK, code)
+	_, err = authFn(grpcCtx(token))
+	r.EqualValues(codes.Unauthenticated, status.Code(err))
+	update(func(u *user.User) { u.Enabled = true })
+	_, err = authFn(grpcCtx(token))
+	r.NoError(err)
+
+	// expired password can only be changed
+	expired := int(time.Now().Add(-time.Minute).Unix())
+	update(func(u *user.User) { u.PwdExpirationDate = &expired })
+	code, token, pwdUpdate = login()
+	r.EqualValues(http.StatusOK, code)
+	r.True(pwdUpdate)
+	_, err = authFn(grpcCtx(token))
+	r.EqualValues(codes.FailedPrecondition, status.Code(err))
+	_, err = authFn(grpcMethodCtx(token, changePasswordMethod))
+	r.NoError(err)
+	r.NoError(userSvc.ChangePassword(ctx, "alice", "secret", "secret"))
+	_, err = authFn(grpcCtx(token))
+	r.NoError(err)
+
+	// password change requested by admin
+	update(func(u *user.User) { u.PwdUpdateRequired = true })
+	_, err = authFn(grpcCtx(token))
+	r.EqualValues(codes.FailedPrecondition, status.Code(err))
+	res, err := srv.Login(ctx, "alice", "secret", "")
+	r.NoError(err)
+	r.True(res.User.PasswordChangeRequired(time.Now()))
+	r.Error(userSvc.ChangePassword(ctx, "alice", "wrong", "new-secret"))
+	r.NoError(userSvc.ChangePassword(ctx, "alice", "secret", "new-secret"))
+	_, err = authFn(grpcCtx(token))
+	r.NoError(err)
+}
+
+func Test_AuthFunc_APIKey(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	userSvc := newTestUserSvc(t)
+	srv := newTestServer(t, userSvc)
+	authFn := AuthFunc(userSvc, srv.Provider(), srv.GetPublicKey, srv.ClientRoles, nil, nil)
+	r.NoError(userSvc.CreateServiceAccount(ctx, "ci", nil, []string{"read-only"}))
+	apiKey, _, err := userSvc.CreateAPIKey(ctx, "ci", "no-expiry", nil)
+	r.NoError(err)
+	expiresAt := time.Now().Add(time.Hour)
+	expiringKey, _, err := userSvc.CreateAPIKey(ctx, "ci", "expiring", &expiresAt)
+	r.NoError(err)
+
+	authCtx, err := authFn(grpcCtx(apiKey))
+	r.NoError(err)
+	r.EqualValues([]string{"read-only"}, xctx.GetRoles(authCtx))
+	r.True(xctx.GetExpiresAt(authCtx).IsZero())
+
+	authCtx, err = authFn(grpcCtx(expiringKey))
+	r.NoError(err)
+	r.Equal(expiresAt.Unix(), xctx.GetExpiresAt(authCtx).Unix())
+
+	_, err = authFn(grpcCtx(apiKey + "0"))
+	r.EqualValues(codes.Unauthenticated, status.Code(err))
+}
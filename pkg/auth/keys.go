@@ -0,0 +1,424 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/ory/fosite/token/jwt"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// legacySigningKeyKey - single signing key stored before key rotation support. Tokens signed with it have kid "0".
+	legacySigningKeyKey = "ceph-api/oauth/signing_key"
+	legacySigningKeyID  = "0"
+	signingKeysKey      = "ceph-api/oauth/signing_keys"
+	secretKey           = "ceph-api/oauth/secret"
+	minSecretLen        = 32
+
+	// keyReloadInterval - how often API replicas reload signing keys from store.
+	keyReloadInterval = time.Minute
+	// keyPropagation - new key is published in JWKS for this time before it is used to sign tokens,
+	// so all replicas and token consumers are able to verify it.
+	keyPropagation = 2 * keyReloadInterval
+)
+
+type signingKey struct {
+	ID  string
+	Key *rsa.PrivateKey
+	// NotBefore - key is used to sign tokens starting from this time
+	NotBefore time.Time
+}
+
+type storedKey struct {
+	ID        string    `json:"kid"`
+	Key       string    `json:"key"`
+	NotBefore time.Time `json:"not_before"`
+}
+
+// keySet holds signing keys ordered by NotBefore. The latest key with NotBefore in the past signs new tokens,
+// other keys are used to verify previously issued tokens.
+type keySet struct {
+	sync.RWMutex
+	keys []signingKey
+}
+
+func (k *keySet) set(keys []signingKey) {
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].NotBefore.Before(keys[j].NotBefore) })
+	k.Lock()
+	defer k.Unlock()
+	k.keys = keys
+}
+
+func (k *keySet) all() []signingKey {
+	k.RLock()
+	defer k.RUnlock()
+	return k.keys
+}
+
+func (k *keySet) signing() (signingKey, error) {
+	k.RLock()
+	defer k.RUnlock()
+	if len(k.keys) == 0 {
+		return signingKey{}, fmt.Errorf("%w: no oauth signing keys", types.ErrInternal)
+	}
+	now := time.Now()
+	res := k.keys[0]
+	for _, key := range k.keys[1:] {
+		if key.NotBefore.After(now) {
+			break
+		}
+		res = key
+	}
+	return res, nil
+}
+
+func (k *keySet) get(kid string) (signingKey, error) {
+	k.RLock()
+	defer k.RUnlock()
+	for _, key := range k.keys {
+		if key.ID == kid {
+			return key, nil
+		}
+	}
+	return signingKey{}, fmt.Errorf("%w: unknown signing key %q", types.ErrUnauthenticated, kid)
+}
+
+// keySigner signs tokens with current signing key and verifies them with the key referenced in "kid" header.
+type keySigner struct {
+	keys *keySet
+}
+
+var _ jwt.Signer = &keySigner{}
+
+func signerFor(key signingKey) *jwt.DefaultSigner {
+	return &jwt.DefaultSigner{GetPrivateKey: func(context.Context) (interface{}, error) { return key.Key, nil }}
+}
+
+func (s *keySigner) Generate(ctx context.Context, claims jwt.MapClaims, header jwt.Mapper) (string, string, error) {
+	key, err := s.keys.signing()
+	if err != nil {
+		return "", "", err
+	}
+	header.Add("kid", key.ID)
+	return signerFor(key).Generate(ctx, claims, header)
+}
+
+func (s *keySigner) Validate(ctx context.Context, token string) (string, error) {
+	key, err := s.tokenKey(token)
+	if err != nil {
+		return "", err
+	}
+	return signerFor(key).Validate(ctx, token)
+}
+
+func (s *keySigner) Decode(ctx context.Context, token string) (*jwt.Token, error) {
+	key, err := s.tokenKey(token)
+	if err != nil {
+		return nil, err
+	}
+	return signerFor(key).Decode(ctx, token)
+}
+
+func (s *keySigner) Hash(ctx context.Context, in []byte) ([]byte, error) {
+	key, err := s.keys.signing()
+	if err != nil {
+		return nil, err
+	}
+	return signerFor(key).Hash(ctx, in)
+}
+
+func (s *keySigner) GetSignature(ctx context.Context, token string) (string, error) {
+	key, err := s.keys.signing()
+	if err != nil {
+		return "", err
+	}
+	return signerFor(key).GetSignature(ctx, token)
+}
+
+func (s *keySigner) GetSigningMethodLength(ctx context.Context) int {
+	key, err := s.keys.signing()
+	if err != nil {
+		return 0
+	}
+	return signerFor(key).GetSigningMethodLength(ctx)
+}
+
+// tokenKey returns key referenced in token "kid" header.
+func (s *keySigner) tokenKey(token string) (signingKey, error) {
+	headerStr, _, _ := strings.Cut(token, ".")
+	headerJSON, err := base64.RawURLEncoding.DecodeString(headerStr)
+	if err != nil {
+		return signingKey{}, fmt.Errorf("%w: invalid jwt header", types.ErrUnauthenticated)
+	}
+	var header struct {
+		KeyID string `json:"kid"`
+	}
+	if err = json.Unmarshal(headerJSON, &header); err != nil {
+		return signingKey{}, fmt.Errorf("%w: invalid jwt header", types.ErrUnauthenticated)
+	}
+	return s.keys.get(header.KeyID)
+}
+
+// loadSigningKeys reads RSA private keys from configured PEM file or from kv store.
+// PEM file can contain multiple keys: the last key signs new tokens. If keys are not configured and not stored yet,
+// a new key is generated and stored.
+func loadSigningKeys(ctx context.Context, conf Config, kv kvStore) ([]signingKey, error) {
+	if conf.SigningKeyFile != "" {
+		data, err := os.ReadFile(conf.SigningKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w: unable to read oauth signing key file", err)
+		}
+		var res []signingKey
+		for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+			key, err := parseRSAKey(block)
+			if err != nil {
+				return nil, err
+			}
+			kid, err := keyID(key)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, signingKey{ID: kid, Key: key})
+		}
+		if len(res) == 0 {
+			return nil, fmt.Errorf("%w: oauth signing key file has no PEM encoded keys", types.ErrInvalidConfig)
+		}
+		return res, nil
+	}
+	keys, err := readStoredKeys(ctx, kv)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) != 0 {
+		return keys, nil
+	}
+	// the first key is used immediately, rotation interval starts now
+	key, err := newSigningKey(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err = storeKeys(ctx, kv, []signingKey{key}); err != nil {
+		return nil, err
+	}
+	// read back to use the same keys if other replica stored them concurrently
+	return readStoredKeys(ctx, kv)
+}
+
+func newSigningKey(notBefore time.Time) (signingKey, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return signingKey{}, err
+	}
+	kid, err := keyID(key)
+	if err != nil {
+		return signingKey{}, err
+	}
+	return signingKey{ID: kid, Key: key, NotBefore: notBefore}, nil
+}
+
+// keyID returns RFC 7638 key thumbprint.
+func keyID(key *rsa.PrivateKey) (string, error) {
+	thumbprint, err := (&jose.JSONWebKey{Key: &key.PublicKey}).Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(thumbprint), nil
+}
+
+func readStoredKeys(ctx context.Context, kv kvStore) ([]signingKey, error) {
+	data, err := kv.Get(ctx, signingKeysKey)
+	if errors.Is(err, types.ErrNotFound) {
+		// migrate single key stored by previous versions
+		legacy, err := kv.Get(ctx, legacySigningKeyKey)
+		if errors.Is(err, types.ErrNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		block, _ := pem.Decode(legacy)
+		key, err := parseRSAKey(block)
+		if err != nil {
+			return nil, err
+		}
+		return []signingKey{{ID: legacySigningKeyID, Key: key}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var stored []storedKey
+	if err = json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("%w: unable to decode oauth signing keys", err)
+	}
+	res := make([]signingKey, 0, len(stored))
+	for _, s := range stored {
+		block, _ := pem.Decode([]byte(s.Key))
+		key, err := parseRSAKey(block)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, signingKey{ID: s.ID, Key: key, NotBefore: s.NotBefore})
+	}
+	return res, nil
+}
+
+func storeKeys(ctx context.Context, kv kvStore, keys []signingKey) error {
+	stored := make([]storedKey, 0, len(keys))
+	for _, key := range keys {
+		der, err := x509.MarshalPKCS8PrivateKey(key.Key)
+		if err != nil {
+			return err
+		}
+		stored = append(stored, storedKey{
+			ID:        key.ID,
+			Key:       string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+			NotBefore: key.NotBefore,
+		})
+	}
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+	return kv.Set(ctx, signingKeysKey, data)
+}
+
+// RotateKeys periodically reloads signing keys from store and rotates them according to config.
+// Blocks until ctx is done. Does nothing if keys are provided in file.
+func (s *Server) RotateKeys(ctx context.Context) error {
+	if s.keyStore == nil {
+		<-ctx.Done()
+		return nil
+	}
+	ticker := time.NewTicker(keyReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := s.rotateKeys(ctx); err != nil {
+			zerolog.Ctx(ctx).Err(err).Msg("unable to rotate oauth signing keys")
+		}
+	}
+}
+
+func (s *Server) rotateKeys(ctx context.Context) error {
+	keys, err := readStoredKeys(ctx, s.keyStore)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		keys = s.keys.all()
+	}
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].NotBefore.Before(keys[j].NotBefore) })
+	changed := false
+	now := time.Now()
+	if s.keyRotationInterval > 0 && !keys[len(keys)-1].NotBefore.Add(s.keyRotationInterval).After(now.Add(keyPropagation)) {
+		key, err := newSigningKey(now.Add(keyPropagation))
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+		changed = true
+		zerolog.Ctx(ctx).Info().Str("kid", key.ID).Time("not_before", key.NotBefore).Msg("new oauth signing key created")
+	}
+	// key is retired when the next key becomes active. Remove keys retired before all tokens signed with them expired.
+	for len(keys) > 1 && keys[1].NotBefore.Add(s.keyRetention).Before(now) {
+		zerolog.Ctx(ctx).Info().Str("kid", keys[0].ID).Msg("oauth signing key removed")
+		keys = keys[1:]
+		changed = true
+	}
+	if changed {
+		if err = storeKeys(ctx, s.keyStore, keys); err != nil {
+			return err
+		}
+		// read back to use the same keys if other replica stored them concurrently
+		if keys, err = readStoredKeys(ctx, s.keyStore); err != nil {
+			return err
+		}
+	}
+	s.keys.set(keys)
+	return nil
+}
+
+// loadSecret reads HMAC secret from configured file or from kv store.
+// If secret is not configured and not stored yet, a new secret is generated and stored.
+func loadSecret(ctx context.Context, conf Config, kv kvStore) ([]byte, error) {
+	var (
+		secret []byte
+		err    error
+	)
+	if conf.SecretFile != "" {
+		secret, err = os.ReadFile(conf.SecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w: unable to read oauth secret file", err)
+		}
+	} else {
+		secret, err = loadOrCreate(ctx, kv, secretKey, func() ([]byte, error) {
+			res := make([]byte, minSecretLen)
+			_, err := rand.Read(res)
+			return res, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(secret) < minSecretLen {
+		return nil, fmt.Errorf("%w: oauth secret should be at least %d bytes", types.ErrInvalidConfig, minSecretLen)
+	}
+	return secret, nil
+}
+
+func loadOrCreate(ctx context.Context, kv kvStore, key string, create func() ([]byte, error)) ([]byte, error) {
+	res, err := kv.Get(ctx, key)
+	if err == nil {
+		return res, nil
+	}
+	if !errors.Is(err, types.ErrNotFound) {
+		return nil, err
+	}
+	res, err = create()
+	if err != nil {
+		return nil, err
+	}
+	if err = kv.Set(ctx, key, res); err != nil {
+		return nil, err
+	}
+	// read back to use the same value if other replica stored it concurrently
+	return kv.Get(ctx, key)
+}
+
+func parseRSAKey(block *pem.Block) (*rsa.PrivateKey, error) {
+	if block == nil {
+		return nil, fmt.Errorf("%w: oauth signing key is not PEM encoded", types.ErrInvalidConfig)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to parse oauth signing key: %v", types.ErrInvalidConfig, err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: oauth signing key is not RSA key", types.ErrInvalidConfig)
+	}
+	return rsaKey, nil
+}
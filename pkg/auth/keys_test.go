@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/ory/fosite/token/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_KeyRotation(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	kv := newMemKV()
+
+	// migrate key stored by previous version
+	legacy, err := newSigningKey(time.Time{})
+	r.NoError(err)
+	der, err := x509.MarshalPKCS8PrivateKey(legacy.Key)
+	r.NoError(err)
+	r.NoError(kv.Set(ctx, legacySigningKeyKey, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})))
+	keys, err := loadSigningKeys(ctx, Config{}, kv)
+	r.NoError(err)
+	r.Len(keys, 1)
+	r.EqualValues(legacySigningKeyID, keys[0].ID)
+
+	s := &Server{keys: &keySet{}, keyStore: kv, keyRotationInterval: time.Hour, keyRetention: time.Hour}
+	s.keys.set(keys)
+	signer := &keySigner{keys: s.keys}
+	oldToken, _, err := signer.Generate(ctx, jwt.MapClaims{"sub": "test"}, &jwt.Headers{})
+	r.NoError(err)
+
+	// new key is published but not used before propagation
+	r.NoError(s.rotateKeys(ctx))
+	r.Len(s.keys.all(), 2)
+	newKey := s.keys.all()[1]
+	r.True(newKey.NotBefore.After(time.Now()))
+	active, err := s.keys.signing()
+	r.NoError(err)
+	r.EqualValues(legacySigningKeyID, active.ID)
+
+	// other replica loads the same keys
+	stored, err := readStoredKeys(ctx, kv)
+	r.NoError(err)
+	r.Len(stored, 2)
+
+	// new key signs tokens after propagation, old tokens are still valid
+	all := s.keys.all()
+	all[1].NotBefore = time.Now().Add(-time.Minute)
+	s.keys.set(all)
+	r.NoError(storeKeys(ctx, kv, all))
+	newToken, _, err := signer.Generate(ctx, jwt.MapClaims{"sub": "test"}, &jwt.Headers{})
+	r.NoError(err)
+	decoded, err := signer.Decode(ctx, newToken)
+	r.NoError(err)
+	r.EqualValues(newKey.ID, decoded.Header["kid"])
+	_, err = signer.Validate(ctx, oldToken)
+	r.NoError(err)
+	pub, err := s.GetPublicKey(legacySigningKeyID)
+	r.NoError(err)
+	r.EqualValues(&legacy.Key.PublicKey, pub)
+
+	// retired key is removed after retention
+	all[1].NotBefore = time.Now().Add(-2 * time.Hour)
+	r.NoError(storeKeys(ctx, kv, all))
+	r.NoError(s.rotateKeys(ctx))
+	r.Len(s.keys.all(), 2, "retired key removed and a new key created for overdue rotation")
+	r.EqualValues(newKey.ID, s.keys.all()[0].ID)
+	_, err = signer.Validate(ctx, oldToken)
+	r.Error(err)
+	_, err = signer.Validate(ctx, newToken)
+	r.NoError(err)
+}
+
+func Test_loadSigningKeys_new(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	kv := newMemKV()
+	keys, err := loadSigningKeys(ctx, Config{}, kv)
+	r.NoError(err)
+	r.Len(keys, 1)
+	r.WithinDuration(time.Now(), keys[0].NotBefore, time.Minute)
+
+	// the first key is not rotated before interval
+	s := &Server{keys: &keySet{}, keyStore: kv, keyRotationInterval: time.Hour, keyRetention: time.Hour}
+	s.keys.set(keys)
+	r.NoError(s.rotateKeys(ctx))
+	r.Len(s.keys.all(), 1)
+	active, err := s.keys.signing()
+	r.NoError(err)
+	r.EqualValues(keys[0].ID, active.ID)
+}
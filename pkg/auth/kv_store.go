@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	goceph "github.com/ceph/go-ceph/rados"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+)
+
+// kvStore - key-value storage for OAuth keys and tokens. Get returns types.ErrNotFound for missing keys.
+type kvStore interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, val []byte) error
+	Delete(ctx context.Context, key string) error
+	// List returns keys with given prefix and their values.
+	List(ctx context.Context, prefix string) (map[string][]byte, error)
+}
+
+// cephKV stores values in Ceph config-key store, so they are shared between API replicas.
+type cephKV struct {
+	radosSvc *rados.Svc
+}
+
+func (c *cephKV) Get(ctx context.Context, key string) ([]byte, error) {
+	cmd, err := rados.MonCmd{Prefix: "config-key get", Args: map[string]any{"key": key}}.Build()
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.radosSvc.ExecMon(ctx, cmd)
+	if errors.Is(err, goceph.ErrNotFound) {
+		return nil, fmt.Errorf("%w: config-key %s", types.ErrNotFound, key)
+	}
+	return res, err
+}
+
+func (c *cephKV) Set(ctx context.Context, key string, val []byte) error {
+	cmd, err := rados.MonCmd{Prefix: "config-key set", Args: map[string]any{"key": key}}.Build()
+	if err != nil {
+		return err
+	}
+	_, err = c.radosSvc.ExecMonWithInputBuff(ctx, cmd, val)
+	return err
+}
+
+func (c *cephKV) Delete(ctx context.Context, key string) error {
+	cmd, err := rados.MonCmd{Prefix: "config-key rm", Args: map[string]any{"key": key}}.Build()
+	if err != nil {
+		return err
+	}
+	_, err = c.radosSvc.ExecMon(ctx, cmd)
+	if errors.Is(err, goceph.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// List dumps keys with given prefix. Prefix is matched by monitor, so other config-keys are not transferred.
+// Binary values are replaced by monitor with placeholders.
+func (c *cephKV) List(ctx context.Context, prefix string) (map[string][]byte, error) {
+	cmd, err := rados.MonCmd{Prefix: "config-key dump", Args: map[string]any{"key": prefix}}.Build()
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.radosSvc.ExecMon(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	var dump map[string]string
+	if err = json.Unmarshal(res, &dump); err != nil {
+		return nil, err
+	}
+	vals := make(map[string][]byte, len(dump))
+	for key, val := range dump {
+		if strings.HasPrefix(key, prefix) {
+			vals[key] = []byte(val)
+		}
+	}
+	return vals, nil
+}
+
+// memKV stores values in memory. Values are lost on restart and not shared between replicas.
+type memKV struct {
+	sync.RWMutex
+	data map[string][]byte
+}
+
+func newMemKV() *memKV {
+	return &memKV{data: map[string][]byte{}}
+}
+
+func (m *memKV) Get(_ context.Context, key string) ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	val, ok := m.data[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: key %s", types.ErrNotFound, key)
+	}
+	return val, nil
+}
+
+func (m *memKV) Set(_ context.Context, key string, val []byte) error {
+	m.Lock()
+	defer m.Unlock()
+	m.data[key] = val
+	return nil
+}
+
+func (m *memKV) Delete(_ context.Context, key string) error {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.data, key)
+	return nil
+}
+
+func (m *memKV) List(_ context.Context, prefix string) (map[string][]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	res := map[string][]byte{}
+	for key, val := range m.data {
+		if strings.HasPrefix(key, prefix) {
+			res[key] = val
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/go-ldap/ldap/v3"
+)
+
+// ldapIssuer - external issuer of users provisioned by LDAP authenticator.
+const ldapIssuer = "ldap"
+
+type ldapAuthenticator struct {
+	conf    LDAPConfig
+	userSvc *user.Service
+}
+
+func newLDAPAuthenticator(conf LDAPConfig, userSvc *user.Service) (*ldapAuthenticator, error) {
+	if conf.URL == "" {
+		return nil, fmt.Errorf("%w: ldap url is not set", types.ErrInvalidConfig)
+	}
+	if conf.BindDN == "" && conf.UserDNTemplate == "" {
+		return nil, fmt.Errorf("%w: either ldap bindDN or userDNTemplate must be set", types.ErrInvalidConfig)
+	}
+	if conf.UserFilter == "" {
+		conf.UserFilter = "(uid=%s)"
+	}
+	if conf.GroupFilter == "" {
+		conf.GroupFilter = "(member=%s)"
+	}
+	if conf.GroupNameAttr == "" {
+		conf.GroupNameAttr = "cn"
+	}
+	if conf.Timeout == 0 {
+		conf.Timeout = 10 * time.Second
+	}
+	return &ldapAuthenticator{conf: conf, userSvc: userSvc}, nil
+}
+
+func (a *ldapAuthenticator) Authenticate(ctx context.Context, username, password string) error {
+	// empty password means anonymous bind which always succeeds
+	if username == "" || password == "" {
+		return fmt.Errorf("%w: empty credentials", types.ErrUnauthenticated)
+	}
+	if usr, err := a.userSvc.GetUser(ctx, username); err == nil && usr.ExternalIssuer != ldapIssuer {
+		return fmt.Errorf("%w: user %s is not managed by ldap", types.ErrUnauthenticated, username)
+	}
+
+	conn, err := a.dial()
+	if err != nil {
+		return fmt.Errorf("%w: unable to connect to ldap: %v", types.ErrUnavailable, err)
+	}
+	defer conn.Close()
+
+	userDN, err := a.userDN(conn, username)
+	if err != nil {
+		return err
+	}
+	if err = conn.Bind(userDN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return fmt.Errorf("%w: invalid credentials", types.ErrUnauthenticated)
+		}
+		return fmt.Errorf("%w: ldap user bind: %v", types.ErrUnavailable, err)
+	}
+	groups, err := a.userGroups(conn, userDN)
+	if err != nil {
+		return err
+	}
+	roles := mapGroups(a.conf.RoleMapping, groups)
+	if len(roles) == 0 {
+		return fmt.Errorf("%w: no roles mapped for ldap user %s", types.ErrUnauthenticated, username)
+	}
+	usr, err := a.userSvc.EnsureExternalUser(ctx, username, ldapIssuer, roles)
+	if err != nil {
+		return err
+	}
+	if !usr.Enabled {
+		return fmt.Errorf("%w: user disabled", types.ErrUnauthenticated)
+	}
+	return nil
+}
+
+func (a *ldapAuthenticator) dial() (*ldap.Conn, error) {
+	tlsConf := &tls.Config{InsecureSkipVerify: a.conf.InsecureSkipVerify}
+	conn, err := ldap.DialURL(a.conf.URL,
+		ldap.DialWithDialer(&net.Dialer{Timeout: a.conf.Timeout}),
+		ldap.DialWithTLSConfig(tlsConf))
+	if err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(a.conf.Timeout)
+	if a.conf.StartTLS {
+		if err = conn.StartTLS(tlsConf); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+// userDN returns user entry DN either from template or by searching with service account.
+func (a *ldapAuthenticator) userDN(conn *ldap.Conn, username string) (string, error) {
+	if a.conf.BindDN == "" {
+		return fmt.Sprintf(a.conf.UserDNTemplate, ldap.EscapeDN(username)), nil
+	}
+	if err := conn.Bind(a.conf.BindDN, a.conf.BindPassword); err != nil {
+		return "", fmt.Errorf("%w: ldap service account bind: %v", types.ErrUnavailable, err)
+	}
+	res, err := conn.Search(ldap.NewSearchRequest(a.conf.UserBaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		2, int(a.conf.Timeout.Seconds()), false,
+		fmt.Sprintf(a.conf.UserFilter, ldap.EscapeFilter(username)), []string{"dn"}, nil))
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return "", fmt.Errorf("%w: ldap user search: %v", types.ErrUnavailable, err)
+	}
+	if res == nil || len(res.Entries) != 1 {
+		return "", fmt.Errorf("%w: ldap user not found or not unique", types.ErrUnauthenticated)
+	}
+	return res.Entries[0].DN, nil
+}
+
+func (a *ldapAuthenticator) userGroups(conn *ldap.Conn, userDN string) ([]string, error) {
+	if a.conf.GroupBaseDN == "" {
+		return nil, nil
+	}
+	res, err := conn.Search(ldap.NewSearchRequest(a.conf.GroupBaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		0, int(a.conf.Timeout.Seconds()), false,
+		fmt.Sprintf(a.conf.GroupFilter, ldap.EscapeFilter(userDN)), []string{a.conf.GroupNameAttr}, nil))
+	if err != nil {
+		var ldapErr *ldap.Error
+		if errors.As(err, &ldapErr) && ldapErr.ResultCode == ldap.LDAPResultNoSuchObject {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%w: ldap group search: %v", types.ErrUnavailable, err)
+	}
+	groups := make([]string, 0, len(res.Entries))
+	for _, e := range res.Entries {
+		for _, name := range e.GetAttributeValues(a.conf.GroupNameAttr) {
+			groups = append(groups, strings.TrimSpace(name))
+		}
+	}
+	return groups, nil
+}
@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/require"
+)
+
+type ldapEntry struct {
+	password string
+	attrs    map[string][]string
+}
+
+// stubLDAP is a minimal LDAP server supporting simple bind and equality filter search.
+type stubLDAP struct {
+	ln      net.Listener
+	entries map[string]ldapEntry
+}
+
+func newStubLDAP(t *testing.T, entries map[string]ldapEntry) *stubLDAP {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	res := &stubLDAP{ln: ln, entries: entries}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go res.serve(conn)
+		}
+	}()
+	return res
+}
+
+func (s *stubLDAP) URL() string {
+	return "ldap://" + s.ln.Addr().String()
+}
+
+func (s *stubLDAP) serve(conn net.Conn) {
+	defer conn.Close()
+	for {
+		req, err := ber.ReadPacket(conn)
+		if err != nil || len(req.Children) < 2 {
+			return
+		}
+		msgID := req.Children[0].Value
+		op := req.Children[1]
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			dn, _ := op.Children[1].Value.(string)
+			entry, ok := s.entries[dn]
+			code := ldap.LDAPResultInvalidCredentials
+			if ok && entry.password != "" && entry.password == op.Children[2].Data.String() {
+				code = ldap.LDAPResultSuccess
+			}
+			s.write(conn, msgID, ldapResult(ldap.ApplicationBindResponse, code))
+		case ldap.ApplicationSearchRequest:
+			baseDN, _ := op.Children[0].Value.(string)
+			filter, _ := ldap.DecompileFilter(op.Children[6])
+			attr, value, _ := strings.Cut(strings.Trim(filter, "()"), "=")
+			for dn, entry := range s.entries {
+				if !strings.HasSuffix(dn, baseDN) || !slices.Contains(entry.attrs[attr], value) {
+					continue
+				}
+				resp := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "entry")
+				resp.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, dn, "dn"))
+				attrs := ber.NewSequence("attributes")
+				for name, vals := range entry.attrs {
+					a := ber.NewSequence("attribute")
+					a.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "type"))
+					set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "vals")
+					for _, v := range vals {
+						set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "val"))
+					}
+					a.AppendChild(set)
+					attrs.AppendChild(a)
+				}
+				resp.AppendChild(attrs)
+				s.write(conn, msgID, resp)
+			}
+			s.write(conn, msgID, ldapResult(ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess))
+		default:
+			return
+		}
+	}
+}
+
+func ldapResult(tag ber.Tag, code int) *ber.Packet {
+	res := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "result")
+	res.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "code"))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "message"))
+	return res
+}
+
+func (s *stubLDAP) write(conn net.Conn, msgID interface{}, op *ber.Packet) {
+	msg := ber.NewSequence("message")
+	msg.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, msgID, "id"))
+	msg.AppendChild(op)
+	conn.Write(msg.Bytes())
+}
+
+func Test_LDAPAuthenticator(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	const aliceDN = "uid=alice,ou=people,dc=example,dc=com"
+	srv := newStubLDAP(t, map[string]ldapEntry{
+		"cn=ceph-api,dc=example,dc=com":       {password: "service"},
+		aliceDN:                               {password: "alice-pass", attrs: map[string][]string{"uid": {"alice"}}},
+		"uid=bob,ou=people,dc=example,dc=com": {password: "bob-pass", attrs: map[string][]string{"uid": {"bob"}}},
+		"cn=ceph-admins,ou=groups,dc=example,dc=com": {attrs: map[string][]string{
+			"cn": {"ceph-admins"}, "member": {aliceDN}}},
+	})
+	userSvc := newTestUserSvc(t)
+	r.NoError(userSvc.CreateUser(ctx, user.User{Username: "local", Password: "local-pass", Enabled: true, Roles: []string{"read-only"}}))
+
+	authenticator, err := newAuthenticator(Config{LDAP: LDAPConfig{
+		Enabled:      true,
+		URL:          srv.URL(),
+		BindDN:       "cn=ceph-api,dc=example,dc=com",
+		BindPassword: "service",
+		UserBaseDN:   "ou=people,dc=example,dc=com",
+		GroupBaseDN:  "ou=groups,dc=example,dc=com",
+		RoleMapping:  []RoleMapping{{Group: "ceph-admins", Roles: []string{"administrator"}}},
+	}}, userSvc)
+	r.NoError(err)
+
+	// local users are still authenticated with access DB
+	r.NoError(authenticator.Authenticate(ctx, "local", "local-pass"))
+	r.ErrorIs(authenticator.Authenticate(ctx, "local", "wrong"), types.ErrUnauthenticated)
+
+	// ldap user is provisioned with mapped roles
+	r.ErrorIs(authenticator.Authenticate(ctx, "alice", "wrong"), types.ErrUnauthenticated)
+	r.ErrorIs(authenticator.Authenticate(ctx, "alice", ""), types.ErrUnauthenticated)
+	r.NoError(authenticator.Authenticate(ctx, "alice", "alice-pass"))
+	usr, err := userSvc.GetUser(ctx, "alice")
+	r.NoError(err)
+	r.EqualValues(ldapIssuer, usr.ExternalIssuer)
+	r.EqualValues([]string{"administrator"}, usr.Roles)
+	// random password of provisioned user is not accepted by local authenticator
+	r.ErrorIs((&localAuthenticator{userSvc: userSvc}).Authenticate(ctx, "alice", usr.Password), types.ErrUnauthenticated)
+
+	// ldap user without mapped groups is rejected
+	r.ErrorIs(authenticator.Authenticate(ctx, "bob", "bob-pass"), types.ErrUnauthenticated)
+	_, err = userSvc.GetUser(ctx, "bob")
+	r.ErrorIs(err, types.ErrNotFound)
+	r.ErrorIs(authenticator.Authenticate(ctx, "unknown", "pass"), types.ErrUnauthenticated)
+
+	// user DN from template without service account
+	ldapAuth, err := newLDAPAuthenticator(LDAPConfig{
+		URL:            srv.URL(),
+		UserDNTemplate: "uid=%s,ou=people,dc=example,dc=com",
+		GroupBaseDN:    "ou=groups,dc=example,dc=com",
+		RoleMapping:    []RoleMapping{{Group: "ceph-admins", Roles: []string{"administrator"}}},
+	}, userSvc)
+	r.NoError(err)
+	r.NoError(ldapAuth.Authenticate(ctx, "alice", "alice-pass"))
+	// local user cannot be taken over by ldap user with the same name
+	r.ErrorIs(ldapAuth.Authenticate(ctx, "local", "local-pass"), types.ErrUnauthenticated)
+}
@@ -0,0 +1,228 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/audit"
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/ory/fosite"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+)
+
+// ErrLoginThrottled - login is rejected without checking credentials because of previous failed attempts.
+var ErrLoginThrottled = fmt.Errorf("%w: too many failed login attempts", types.ErrUnavailable)
+
+var errLoginThrottledGrant = &fosite.RFC6749Error{
+	ErrorField:       "login_throttled",
+	DescriptionField: "Too many failed login attempts.",
+	HintField:        "Retry later.",
+	CodeField:        http.StatusTooManyRequests,
+}
+
+// maxLoginCounters - max number of failure counters kept per username and per source IP.
+// Oldest counters are evicted when it is reached, e.g. by logins with random usernames.
+const maxLoginCounters = 100_000
+
+type loginAttempts struct {
+	failures     int
+	last         time.Time
+	blockedUntil time.Time
+}
+
+// loginLimiter counts failed logins per username and per source IP. Next attempt is delayed with exponential backoff
+// and user is disabled after too many consecutive failures. Counters are kept in memory of API replica.
+type loginLimiter struct {
+	conf     LockoutConfig
+	userSvc  *user.Service
+	auditLog *audit.Log
+
+	mu       sync.Mutex
+	users    map[string]*loginAttempts
+	ips      map[string]*loginAttempts
+	prunedAt time.Time
+}
+
+func newLoginLimiter(conf LockoutConfig, userSvc *user.Service, auditLog *audit.Log) *loginLimiter {
+	return &loginLimiter{
+		conf:     conf,
+		userSvc:  userSvc,
+		auditLog: auditLog,
+		users:    map[string]*loginAttempts{},
+		ips:      map[string]*loginAttempts{},
+	}
+}
+
+// check returns ErrLoginThrottled if login of username from request source IP must be delayed.
+func (l *loginLimiter) check(ctx context.Context, username string) error {
+	now := time.Now()
+	l.mu.Lock()
+	wait := l.wait(l.users, username, now)
+	if ip := audit.SourceIP(ctx); ip != "" {
+		wait = max(wait, l.wait(l.ips, ip, now))
+	}
+	l.mu.Unlock()
+	if wait > 0 {
+		return fmt.Errorf("%w, retry in %s", ErrLoginThrottled, wait.Round(time.Second))
+	}
+	return nil
+}
+
+func (l *loginLimiter) wait(m map[string]*loginAttempts, key string, now time.Time) time.Duration {
+	a, ok := m[key]
+	if !ok || l.expired(a, now) {
+		return 0
+	}
+	return max(a.blockedUntil.Sub(now), 0)
+}
+
+func (l *loginLimiter) expired(a *loginAttempts, now time.Time) bool {
+	return l.conf.ResetAfter > 0 && now.Sub(a.last) > l.conf.ResetAfter
+}
+
+// failed records failed login attempt and disables user if lockout threshold is reached.
+func (l *loginLimiter) failed(ctx context.Context, username, reason string) {
+	now := time.Now()
+	ip := audit.SourceIP(ctx)
+	l.mu.Lock()
+	failures := l.record(l.users, username, now, 0)
+	if ip != "" {
+		l.record(l.ips, ip, now, l.conf.IPFreeAttempts)
+	}
+	l.prune(now)
+	l.mu.Unlock()
+
+	l.audit(ctx, "login_failed", username, fmt.Sprintf("%s, failures: %d", reason, failures), codes.Unauthenticated).Int("failures", failures).Msg("failed login attempt")
+	if l.conf.Attempts == 0 || failures < l.conf.Attempts {
+		return
+	}
+	usr, err := l.userSvc.GetUser(ctx, username)
+	// service accounts cannot log in with password, so they are never locked
+	if err != nil || !usr.Enabled || usr.ServiceAccount {
+		return
+	}
+	if err = l.userSvc.SetEnabled(ctx, username, false); err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("username", username).Msg("unable to lock user")
+		return
+	}
+	l.audit(ctx, "account_locked", username, fmt.Sprintf("%s, failures: %d", reason, failures), codes.Unauthenticated).Int("failures", failures).Msg("user locked after too many failed login attempts")
+}
+
+// record increments failures and returns their number. Backoff is applied after free attempts.
+func (l *loginLimiter) record(m map[string]*loginAttempts, key string, now time.Time, free int) int {
+	a, ok := m[key]
+	if !ok || l.expired(a, now) {
+		if !ok && len(m) >= maxLoginCounters {
+			evictOldest(m, maxLoginCounters*9/10)
+		}
+		a = &loginAttempts{}
+		m[key] = a
+	}
+	a.failures++
+	a.last = now
+	if n := a.failures - free; n > 0 && l.conf.BackoffBase > 0 {
+		// BackoffMax 0 - no cap, doubling stops before overflow
+		backoff := l.conf.BackoffBase
+		for i := 1; i < n && (l.conf.BackoffMax == 0 || backoff < l.conf.BackoffMax) && backoff <= math.MaxInt64/2; i++ {
+			backoff *= 2
+		}
+		if l.conf.BackoffMax > 0 {
+			backoff = min(backoff, l.conf.BackoffMax)
+		}
+		a.blockedUntil = now.Add(backoff)
+	}
+	return a.failures
+}
+
+// prune removes expired counters. Must be called under lock.
+// Counters never expire if ResetAfter is 0, their number is limited by maxLoginCounters in record.
+func (l *loginLimiter) prune(now time.Time) {
+	if l.conf.ResetAfter == 0 || now.Sub(l.prunedAt) < time.Minute {
+		return
+	}
+	l.prunedAt = now
+	for _, m := range []map[string]*loginAttempts{l.users, l.ips} {
+		for k, a := range m {
+			if l.expired(a, now) {
+				delete(m, k)
+			}
+		}
+	}
+}
+
+// evictOldest removes counters with the oldest failures until size is reached.
+func evictOldest(m map[string]*loginAttempts, size int) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return m[keys[i]].last.Before(m[keys[j]].last)
+	})
+	for _, k := range keys[:max(len(keys)-size, 0)] {
+		delete(m, k)
+	}
+}
+
+// succeeded resets failures of user after successful login. Source IP failures expire with time.
+func (l *loginLimiter) succeeded(username string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.users, username)
+}
+
+// throttledAuthenticator rejects logins during backoff and records failed attempts.
+type throttledAuthenticator struct {
+	next    Authenticator
+	limiter *loginLimiter
+}
+
+func (a *throttledAuthenticator) Authenticate(ctx context.Context, username, password string) error {
+	if err := a.limiter.check(ctx, username); err != nil {
+		return err
+	}
+	err := a.next.Authenticate(ctx, username, password)
+	if errors.Is(err, types.ErrUnauthenticated) {
+		a.limiter.failed(ctx, username, "invalid credentials")
+	}
+	return err
+}
+
+// UnlockUser enables user locked after failed login attempts and resets its failures counter.
+func (s *Server) UnlockUser(ctx context.Context, username string) error {
+	if err := s.userSvc.SetEnabled(ctx, username, true); err != nil {
+		return err
+	}
+	s.limiter.succeeded(username)
+	s.limiter.audit(ctx, "account_unlocked", username, "unlocked by "+xctx.GetUsername(ctx), codes.OK).Msg("user unlocked")
+	return nil
+}
+
+// audit writes authentication event to audit log and returns log event with the same fields.
+func (l *loginLimiter) audit(ctx context.Context, event, username, reason string, code codes.Code) *zerolog.Event {
+	l.auditLog.Record(ctx, audit.Event{Event: event, Username: username, Reason: reason, Code: code.String()})
+	return zerolog.Ctx(ctx).Warn().Str("audit_event", event).Str("username", username).Str("source_ip", audit.SourceIP(ctx)).Str("reason", reason)
+}
+
+// withRequestSourceIP sets source IP of http request unless it is already set.
+func withRequestSourceIP(req *http.Request) context.Context {
+	ctx := req.Context()
+	if xctx.GetSourceIP(ctx) != "" {
+		return ctx
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	return xctx.SetSourceIP(ctx, host)
+}
@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/audit"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_loginLimiter_backoff(t *testing.T) {
+	l := newLoginLimiter(LockoutConfig{BackoffBase: time.Second, BackoffMax: 5 * time.Second, IPFreeAttempts: 2}, nil, nil)
+	now := time.Now()
+	var got []time.Duration
+	for i := 0; i < 5; i++ {
+		l.record(l.users, "alice", now, 0)
+		got = append(got, l.wait(l.users, "alice", now))
+	}
+	require.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, got)
+
+	l.record(l.ips, "192.0.2.1", now, 2)
+	l.record(l.ips, "192.0.2.1", now, 2)
+	require.Zero(t, l.wait(l.ips, "192.0.2.1", now), "free attempts")
+	l.record(l.ips, "192.0.2.1", now, 2)
+	require.Equal(t, time.Second, l.wait(l.ips, "192.0.2.1", now))
+
+	// no max backoff
+	l = newLoginLimiter(LockoutConfig{BackoffBase: time.Second}, nil, nil)
+	got = nil
+	for i := 0; i < 4; i++ {
+		l.record(l.users, "alice", now, 0)
+		got = append(got, l.wait(l.users, "alice", now))
+	}
+	require.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second}, got)
+	for i := 0; i < 100; i++ {
+		l.record(l.users, "alice", now, 0)
+	}
+	require.Positive(t, l.wait(l.users, "alice", now), "no overflow")
+}
+
+func Test_loginLimiter_maxCounters(t *testing.T) {
+	// counters never expire without ResetAfter
+	l := newLoginLimiter(LockoutConfig{BackoffBase: time.Second}, nil, nil)
+	now := time.Now()
+	for i := 0; i < maxLoginCounters; i++ {
+		l.users[fmt.Sprintf("user-%d", i)] = &loginAttempts{failures: 1, last: now.Add(time.Duration(i))}
+	}
+	l.record(l.users, "alice", now.Add(time.Hour), 0)
+	require.LessOrEqual(t, len(l.users), maxLoginCounters*9/10+1)
+	require.Contains(t, l.users, "alice")
+	require.Contains(t, l.users, fmt.Sprintf("user-%d", maxLoginCounters-1), "newest counters are kept")
+	require.NotContains(t, l.users, "user-0", "oldest counters are evicted")
+}
+
+func Test_Lockout(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	userSvc := newTestUserSvc(t)
+	srv := newTestServer(t, userSvc)
+	const backoff = 50 * time.Millisecond
+	srv.limiter.conf = LockoutConfig{Attempts: 3, BackoffBase: backoff, BackoffMax: backoff, IPFreeAttempts: 100, ResetAfter: time.Hour}
+	auditConf := audit.Config{Enabled: true, Sink: audit.SinkFile, File: audit.FileConfig{Path: filepath.Join(t.TempDir(), "audit.log"), MaxSizeMB: 1}}
+	auditLog, err := audit.New(ctx, auditConf, rados.NewWithConn(&configKeyConn{data: map[string][]byte{}}))
+	r.NoError(err)
+	defer auditLog.Close()
+	srv.auditLog, srv.limiter.auditLog = auditLog, auditLog
+	for _, name := range []string{"alice", "bob"} {
+		r.NoError(userSvc.CreateUser(ctx, user.User{Username: name, Password: "secret", Roles: []string{"read-only"}, Enabled: true}))
+	}
+	login := func(username, password string) int {
+		form := url.Values{"grant_type": {GrantPassword}, "username": {username}, "password": {password}}
+		code, _ := requestToken(srv, form, "ceph-api", "")
+		return code
+	}
+
+	// backoff after failure, success resets counter
+	r.EqualValues(http.StatusBadRequest, login("alice", "wrong"))
+	r.EqualValues(http.StatusTooManyRequests, login("alice", "secret"))
+	r.EqualValues(http.StatusOK, login("bob", "secret"), "other users are not affected")
+	time.Sleep(backoff)
+	r.EqualValues(http.StatusOK, login("alice", "secret"))
+
+	// lockout
+	for i := 0; i < 3; i++ {
+		r.EqualValues(http.StatusBadRequest, login("alice", "wrong"))
+		time.Sleep(backoff)
+	}
+	usr, err := userSvc.GetUser(ctx, "alice")
+	r.NoError(err)
+	r.False(usr.Enabled)
+	r.EqualValues(http.StatusBadRequest, login("alice", "secret"))
+
+	r.NoError(srv.UnlockUser(ctx, "alice"))
+	usr, err = userSvc.GetUser(ctx, "alice")
+	r.NoError(err)
+	r.True(usr.Enabled)
+	r.EqualValues(http.StatusOK, login("alice", "secret"))
+	events, intact, err := auditLog.List(ctx, audit.ListOpts{Username: "alice"})
+	r.NoError(err)
+	r.True(intact)
+	var got []string
+	for _, e := range events {
+		got = append(got, e.Event)
+	}
+	r.Equal([]string{"login_failed", "token_issued", "login_failed", "login_failed", "login_failed", "account_locked", "login_failed", "account_unlocked", "token_issued"}, got)
+
+	// source IP backoff, previous failures from IP are counted too
+	srv.limiter.conf.IPFreeAttempts = 5
+	srv.limiter.conf.BackoffBase, srv.limiter.conf.BackoffMax = time.Minute, time.Minute
+	r.EqualValues(http.StatusBadRequest, login("nobody-1", "wrong"))
+	r.EqualValues(http.StatusTooManyRequests, login("bob", "secret"))
+	_, err = srv.Login(ctx, "bob", "secret", "")
+	r.NoError(err, "grpc login without source ip")
+	_, err = srv.Login(ctx, "nobody-1", "wrong", "")
+	r.ErrorIs(err, ErrLoginThrottled)
+}
@@ -0,0 +1,331 @@
+package auth
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/ory/fosite"
+	"github.com/rs/zerolog"
+)
+
+const (
+	totpPeriod    = 30
+	totpDigits    = 6
+	totpSecretLen = 20
+	// totpSkew - number of time steps before and after current one accepted to tolerate clock drift.
+	totpSkew         = 1
+	recoveryCodesNum = 10
+)
+
+var (
+	// ErrMFARequired - password is valid, but user has MFA enabled and one-time password is missing.
+	ErrMFARequired = fmt.Errorf("%w: one-time password required", types.ErrUnauthenticated)
+	// ErrMFAEnrollmentRequired - user role requires MFA, but user has not enrolled yet.
+	ErrMFAEnrollmentRequired = fmt.Errorf("%w: mfa enrollment required by user role", types.ErrUnauthenticated)
+
+	errMFARequiredGrant = &fosite.RFC6749Error{
+		ErrorField:       "mfa_required",
+		DescriptionField: "One-time password is required.",
+		HintField:        "Provide TOTP or recovery code in otp parameter.",
+		CodeField:        http.StatusUnauthorized,
+	}
+	errMFAEnrollmentRequiredGrant = &fosite.RFC6749Error{
+		ErrorField:       "mfa_enrollment_required",
+		DescriptionField: "Multi-factor authentication is required for user role.",
+		HintField:        "Enroll TOTP with /api/auth/mfa/enroll and /api/auth/mfa/verify.",
+		CodeField:        http.StatusUnauthorized,
+	}
+)
+
+// MFAEnrollment - TOTP secret to be added to authenticator app.
+type MFAEnrollment struct {
+	// Secret - base32 encoded secret.
+	Secret string
+	// URI - otpauth:// URI, usually shown as QR code.
+	URI string
+}
+
+// loadMFAKey reads key of TOTP secrets from configured file. OAuth HMAC secret is used if file is not set.
+func loadMFAKey(ctx context.Context, conf Config, secret []byte) ([]byte, error) {
+	if conf.MFA.KeyFile == "" {
+		if conf.SecretFile == "" {
+			zerolog.Ctx(ctx).Warn().Msg("auth.mfa.keyFile is not set: TOTP secrets are encrypted with key derived from OAuth secret stored in Ceph config-key store next to access DB")
+		}
+		return secret, nil
+	}
+	key, err := os.ReadFile(conf.MFA.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to read mfa key file", err)
+	}
+	if len(key) < minSecretLen {
+		return nil, fmt.Errorf("%w: mfa key should be at least %d bytes", types.ErrInvalidConfig, minSecretLen)
+	}
+	return key, nil
+}
+
+// newMFACipher returns cipher for TOTP secrets stored in access DB. Encryption key is derived from given key.
+func newMFACipher(secret []byte) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("ceph-api mfa secret encryption"))
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptMFASecret encrypts TOTP secret. Username is authenticated, so secret cannot be copied to other user.
+func (s *Server) encryptMFASecret(username string, secret []byte) (string, error) {
+	nonce := make([]byte, s.mfaCipher.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(s.mfaCipher.Seal(nonce, nonce, secret, []byte(username))), nil
+}
+
+func (s *Server) decryptMFASecret(username, enc string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(enc)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < s.mfaCipher.NonceSize() {
+		return nil, fmt.Errorf("%w: invalid mfa secret", types.ErrInternal)
+	}
+	nonce, data := data[:s.mfaCipher.NonceSize()], data[s.mfaCipher.NonceSize():]
+	res, err := s.mfaCipher.Open(nil, nonce, data, []byte(username))
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to decrypt mfa secret: %v", types.ErrInternal, err)
+	}
+	return res, nil
+}
+
+// totpCode returns RFC 6238 one-time password for given time step.
+func totpCode(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, code%1_000_000)
+}
+
+// verifyTOTP returns time step of matching code.
+func verifyTOTP(secret []byte, code string, now time.Time) (int64, bool) {
+	cur := now.Unix() / totpPeriod
+	for step := cur - totpSkew; step <= cur+totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+func newRecoveryCodes() (codes, hashes []string, err error) {
+	for i := 0; i < recoveryCodesNum; i++ {
+		buf := make([]byte, 5)
+		if _, err = rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		code := hex.EncodeToString(buf)
+		code = code[:5] + "-" + code[5:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// verifyOTP checks TOTP or recovery code and updates mfa state to prevent code reuse.
+func (s *Server) verifyOTP(username string, mfa *user.MFA, otp string) error {
+	secret, err := s.decryptMFASecret(username, mfa.Secret)
+	if err != nil {
+		return err
+	}
+	otp = strings.ReplaceAll(strings.TrimSpace(otp), " ", "")
+	if step, ok := verifyTOTP(secret, otp, time.Now()); ok {
+		if step <= mfa.LastUsedStep {
+			return fmt.Errorf("%w: one-time password already used", types.ErrUnauthenticated)
+		}
+		mfa.LastUsedStep = step
+		return nil
+	}
+	if idx := slices.Index(mfa.RecoveryCodes, hashRecoveryCode(otp)); idx >= 0 {
+		mfa.RecoveryCodes = slices.Delete(mfa.RecoveryCodes, idx, idx+1)
+		return nil
+	}
+	return fmt.Errorf("%w: invalid one-time password", types.ErrUnauthenticated)
+}
+
+func (s *Server) mfaRequired(usr user.User) bool {
+	for _, role := range usr.Roles {
+		if slices.Contains(s.mfaConf.RequiredRoles, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkMFA verifies second factor of password login of already authenticated user.
+func (s *Server) checkMFA(ctx context.Context, username, otp string) error {
+	usr, err := s.userSvc.GetUser(ctx, username)
+	if err != nil {
+		return err
+	}
+	if !usr.MFAEnabled() {
+		if s.mfaRequired(usr) {
+			return ErrMFAEnrollmentRequired
+		}
+		return nil
+	}
+	if otp == "" {
+		return ErrMFARequired
+	}
+	err = s.userSvc.UpdateMFA(ctx, username, func(mfa *user.MFA) (*user.MFA, error) {
+		if mfa == nil || !mfa.Enabled {
+			return nil, fmt.Errorf("%w: mfa disabled concurrently", types.ErrUnauthenticated)
+		}
+		return mfa, s.verifyOTP(username, mfa, otp)
+	})
+	s.otpFailed(ctx, username, err)
+	return err
+}
+
+// otpFailed records invalid one-time password as failed login attempt.
+func (s *Server) otpFailed(ctx context.Context, username string, err error) {
+	if errors.Is(err, types.ErrUnauthenticated) {
+		s.limiter.failed(ctx, username, "invalid one-time password")
+	}
+}
+
+// mfaGrantError converts checkMFA error to OAuth error response.
+func mfaGrantError(err error) error {
+	switch {
+	case errors.Is(err, ErrMFARequired):
+		return errMFARequiredGrant
+	case errors.Is(err, ErrMFAEnrollmentRequired):
+		return errMFAEnrollmentRequiredGrant
+	case errors.Is(err, types.ErrUnauthenticated):
+		return fosite.ErrInvalidGrant.WithHint("Invalid one-time password.").WithDebug(err.Error())
+	}
+	return fosite.ErrServerError.WithWrap(err).WithDebug(err.Error())
+}
+
+// EnrollMFA generates new TOTP secret for user. Enrollment is completed with VerifyMFA.
+// User is authenticated with password, so users required to use MFA can enroll before the first login.
+func (s *Server) EnrollMFA(ctx context.Context, username, password string) (MFAEnrollment, error) {
+	if err := s.authenticator.Authenticate(ctx, username, password); err != nil {
+		return MFAEnrollment{}, err
+	}
+	secret := make([]byte, totpSecretLen)
+	if _, err := rand.Read(secret); err != nil {
+		return MFAEnrollment{}, err
+	}
+	enc, err := s.encryptMFASecret(username, secret)
+	if err != nil {
+		return MFAEnrollment{}, err
+	}
+	err = s.userSvc.UpdateMFA(ctx, username, func(mfa *user.MFA) (*user.MFA, error) {
+		if mfa != nil && mfa.Enabled {
+			return nil, fmt.Errorf("%w: mfa already enabled, disable it first", types.ErrAlreadyExists)
+		}
+		return &user.MFA{Secret: enc, CreatedAt: time.Now().Unix()}, nil
+	})
+	if err != nil {
+		return MFAEnrollment{}, err
+	}
+	encoded := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(secret)
+	uri := url.URL{
+		Scheme: "otpauth",
+		Host:   "totp",
+		Path:   "/" + s.mfaConf.Issuer + ":" + username,
+		RawQuery: url.Values{
+			"secret":    {encoded},
+			"issuer":    {s.mfaConf.Issuer},
+			"algorithm": {"SHA1"},
+			"digits":    {fmt.Sprint(totpDigits)},
+			"period":    {fmt.Sprint(totpPeriod)},
+		}.Encode(),
+	}
+	return MFAEnrollment{Secret: encoded, URI: uri.String()}, nil
+}
+
+// VerifyMFA completes enrollment with one-time password from authenticator app and returns recovery codes.
+// Recovery codes are stored hashed and cannot be retrieved later.
+func (s *Server) VerifyMFA(ctx context.Context, username, password, otp string) ([]string, error) {
+	if err := s.authenticator.Authenticate(ctx, username, password); err != nil {
+		return nil, err
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	err = s.userSvc.UpdateMFA(ctx, username, func(mfa *user.MFA) (*user.MFA, error) {
+		if mfa == nil {
+			return nil, fmt.Errorf("%w: mfa enrollment not started", types.ErrNotFound)
+		}
+		if mfa.Enabled {
+			return nil, fmt.Errorf("%w: mfa already enabled", types.ErrAlreadyExists)
+		}
+		secret, err := s.decryptMFASecret(username, mfa.Secret)
+		if err != nil {
+			return nil, err
+		}
+		step, ok := verifyTOTP(secret, strings.TrimSpace(otp), time.Now())
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid one-time password", types.ErrUnauthenticated)
+		}
+		mfa.Enabled = true
+		mfa.LastUsedStep = step
+		mfa.RecoveryCodes = hashes
+		return mfa, nil
+	})
+	s.otpFailed(ctx, username, err)
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// DisableMFA removes TOTP secret of user. One-time password or recovery code is required if MFA is enabled.
+func (s *Server) DisableMFA(ctx context.Context, username, password, otp string) error {
+	if err := s.authenticator.Authenticate(ctx, username, password); err != nil {
+		return err
+	}
+	err := s.userSvc.UpdateMFA(ctx, username, func(mfa *user.MFA) (*user.MFA, error) {
+		if mfa == nil || !mfa.Enabled {
+			return nil, nil
+		}
+		if err := s.verifyOTP(username, mfa, otp); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	})
+	s.otpFailed(ctx, username, err)
+	return err
+}
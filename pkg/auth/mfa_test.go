@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"context"
+	"encoding/base32"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_totpCode(t *testing.T) {
+	// RFC 6238 test vectors truncated to 6 digits
+	secret := []byte("12345678901234567890")
+	require.Equal(t, "287082", totpCode(secret, 59/totpPeriod))
+	require.Equal(t, "081804", totpCode(secret, 1111111109/totpPeriod))
+	require.Equal(t, "005924", totpCode(secret, 1234567890/totpPeriod))
+
+	step, ok := verifyTOTP(secret, "081804", time.Unix(1111111109+totpPeriod, 0))
+	require.True(t, ok, "previous step accepted")
+	require.EqualValues(t, 1111111109/totpPeriod, step)
+	_, ok = verifyTOTP(secret, "081804", time.Unix(1111111109+3*totpPeriod, 0))
+	require.False(t, ok)
+}
+
+func Test_MFA(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	userSvc := newTestUserSvc(t)
+	srv := newTestServer(t, userSvc)
+	r.NoError(userSvc.CreateUser(ctx, user.User{Username: "alice", Password: "secret", Roles: []string{"read-only"}, Enabled: true}))
+	passwordGrant := func(otp string) int {
+		form := url.Values{"grant_type": {GrantPassword}, "username": {"alice"}, "password": {"secret"}, "otp": {otp}}
+		code, _ := requestToken(srv, form, "ceph-api", "")
+		return code
+	}
+	r.EqualValues(http.StatusOK, passwordGrant(""))
+
+	// enrollment
+	_, err := srv.EnrollMFA(ctx, "alice", "wrong")
+	r.ErrorIs(err, types.ErrUnauthenticated)
+	enrollment, err := srv.EnrollMFA(ctx, "alice", "secret")
+	r.NoError(err)
+	r.True(strings.HasPrefix(enrollment.URI, "otpauth://totp/"))
+	r.Contains(enrollment.URI, "secret="+enrollment.Secret)
+	secret, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(enrollment.Secret)
+	r.NoError(err)
+	// not enabled until verified
+	r.EqualValues(http.StatusOK, passwordGrant(""))
+
+	usr, err := userSvc.GetUser(ctx, "alice")
+	r.NoError(err)
+	r.NotContains(usr.MFA.Secret, enrollment.Secret)
+	_, err = srv.decryptMFASecret("bob", usr.MFA.Secret)
+	r.Error(err, "secret is bound to user")
+
+	step := time.Now().Unix() / totpPeriod
+	_, err = srv.VerifyMFA(ctx, "alice", "secret", "000000")
+	r.ErrorIs(err, types.ErrUnauthenticated)
+	codes, err := srv.VerifyMFA(ctx, "alice", "secret", totpCode(secret, step))
+	r.NoError(err)
+	r.Len(codes, recoveryCodesNum)
+	_, err = srv.EnrollMFA(ctx, "alice", "secret")
+	r.ErrorIs(err, types.ErrAlreadyExists)
+	usr, err = userSvc.GetUser(ctx, "alice")
+	r.NoError(err)
+	r.True(usr.MFAEnabled())
+	r.NotContains(usr.MFA.RecoveryCodes, codes[0])
+
+	// login requires otp
+	_, err = srv.Login(ctx, "alice", "secret", "")
+	r.ErrorIs(err, ErrMFARequired)
+	r.EqualValues(http.StatusUnauthorized, passwordGrant(""))
+	r.EqualValues(http.StatusBadRequest, passwordGrant("000000"))
+	r.EqualValues(http.StatusBadRequest, passwordGrant(totpCode(secret, step)), "code replay")
+	r.EqualValues(http.StatusOK, passwordGrant(totpCode(secret, step+1)))
+	res, err := srv.Login(ctx, "alice", "secret", codes[0])
+	r.NoError(err)
+	r.NotEmpty(res.Token)
+	_, err = srv.Login(ctx, "alice", "secret", codes[0])
+	r.ErrorIs(err, types.ErrUnauthenticated, "recovery code is single-use")
+
+	// disable
+	r.ErrorIs(srv.DisableMFA(ctx, "alice", "secret", ""), types.ErrUnauthenticated)
+	r.NoError(srv.DisableMFA(ctx, "alice", "secret", codes[1]))
+	r.EqualValues(http.StatusOK, passwordGrant(""))
+
+	// role policy
+	srv.mfaConf.RequiredRoles = []string{"read-only"}
+	_, err = srv.Login(ctx, "alice", "secret", "")
+	r.ErrorIs(err, ErrMFAEnrollmentRequired)
+	enrollment, err = srv.EnrollMFA(ctx, "alice", "secret")
+	r.NoError(err)
+	secret, err = base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(enrollment.Secret)
+	r.NoError(err)
+	_, err = srv.VerifyMFA(ctx, "alice", "secret", totpCode(secret, step))
+	r.NoError(err)
+	r.EqualValues(http.StatusOK, passwordGrant(totpCode(secret, step+1)))
+
+	// admin reset
+	r.NoError(userSvc.ResetMFA(ctx, "alice"))
+	_, err = srv.Login(ctx, "alice", "secret", "")
+	r.ErrorIs(err, ErrMFAEnrollmentRequired)
+}
+
+func Test_loadMFAKey(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	secret := []byte(strings.Repeat("s", minSecretLen))
+	key, err := loadMFAKey(ctx, Config{}, secret)
+	r.NoError(err)
+	r.EqualValues(secret, key, "derived from oauth secret if file is not set")
+
+	keyFile := filepath.Join(t.TempDir(), "mfa.key")
+	r.NoError(os.WriteFile(keyFile, []byte("short"), 0o600))
+	_, err = loadMFAKey(ctx, Config{MFA: MFAConfig{KeyFile: keyFile}}, secret)
+	r.ErrorIs(err, types.ErrInvalidConfig)
+	r.NoError(os.WriteFile(keyFile, []byte(strings.Repeat("k", minSecretLen)), 0o600))
+	key, err = loadMFAKey(ctx, Config{MFA: MFAConfig{KeyFile: keyFile}}, secret)
+	r.NoError(err)
+	r.NotEqualValues(secret, key)
+
+	// secret encrypted with other key cannot be decrypted
+	s1, s2 := &Server{}, &Server{}
+	s1.mfaCipher, err = newMFACipher(secret)
+	r.NoError(err)
+	s2.mfaCipher, err = newMFACipher(key)
+	r.NoError(err)
+	enc, err := s1.encryptMFASecret("alice", []byte("totp"))
+	r.NoError(err)
+	_, err = s2.decryptMFASecret("alice", enc)
+	r.ErrorIs(err, types.ErrInternal)
+}
@@ -9,19 +9,26 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/clyso/ceph-api/pkg/audit"
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
 	"github.com/clyso/ceph-api/pkg/types"
 	"github.com/clyso/ceph-api/pkg/user"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 )
 
-func (s *Server) Login(ctx context.Context, username, password string) (*LoginResp, error) {
+func (s *Server) Login(ctx context.Context, username, password, otp string) (*LoginResp, error) {
 	v := url.Values{
 		"grant_type": {"password"},
 		"username":   {username},
 		"password":   {password},
 		"client_id":  {s.clientID},
 	}
-	req, err := http.NewRequest("POST", "http://localhost:80/api/auth", strings.NewReader(v.Encode()))
+	if otp != "" {
+		v.Set("otp", otp)
+	}
+	// token endpoint uses source IP of grpc request for brute-force protection
+	ctx = xctx.SetSourceIP(ctx, audit.SourceIP(ctx))
+	req, err := http.NewRequestWithContext(ctx, "POST", "http://localhost:80/api/auth", strings.NewReader(v.Encode()))
 	if err != nil {
 		return nil, err
 	}
@@ -30,6 +37,18 @@ func (s *Server) Login(ctx context.Context, username, password string) (*LoginRe
 	resp := httptest.NewRecorder()
 	s.TokenEndpoint(resp, req)
 	if resp.Code < 200 || resp.Code > 299 {
+		errBody := struct {
+			Error string `json:"error"`
+		}{}
+		json.Unmarshal(resp.Body.Bytes(), &errBody)
+		switch errBody.Error {
+		case errMFARequiredGrant.ErrorField:
+			return nil, ErrMFARequired
+		case errMFAEnrollmentRequiredGrant.ErrorField:
+			return nil, ErrMFAEnrollmentRequired
+		case errLoginThrottledGrant.ErrorField:
+			return nil, ErrLoginThrottled
+		}
 		return nil, types.ErrUnauthenticated
 	}
 	resBody := struct {
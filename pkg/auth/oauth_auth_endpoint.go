@@ -2,19 +2,23 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
+	"github.com/clyso/ceph-api/pkg/types"
 	"github.com/ory/fosite"
 	"github.com/ory/fosite/handler/oauth2"
 	"github.com/rs/zerolog"
-	"golang.org/x/crypto/bcrypt"
 )
 
+// AuthEndpoint handles authorization code flow. GET request renders login and consent page,
+// POST request with user credentials issues authorization code and redirects to client.
 func (s *Server) AuthEndpoint(rw http.ResponseWriter, req *http.Request) {
 	// This context will be passed to all methods.
-	ctx := req.Context()
+	ctx := withRequestSourceIP(req)
 	log := zerolog.Ctx(req.Context())
 
+	// client, redirect uri and PKCE challenge are validated here
 	ar, err := s.provider.NewAuthorizeRequest(ctx, req)
 	if err != nil {
 		fositeError := fosite.ErrorToRFC6749Error(err)
@@ -23,6 +27,12 @@ func (s *Server) AuthEndpoint(rw http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	// fosite checks PKCE only when response is created, fail before user enters credentials
+	if err = validatePKCE(ar); err != nil {
+		s.provider.WriteAuthorizeError(ctx, rw, ar, err)
+		return
+	}
+
 	prompt := ar.GetRequestForm().Get("prompt")
 	if prompt == "none" {
 		err := fosite.ErrLoginRequired.WithHint("Failed validate open id request cause prompt set to none but there is no valid login session for this user")
@@ -30,32 +40,59 @@ func (s *Server) AuthEndpoint(rw http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	if req.Method == http.MethodPost && ar.GetRequestForm().Get("deny") != "" {
+		s.provider.WriteAuthorizeError(ctx, rw, ar, fosite.ErrAccessDenied.WithHint("The resource owner denied the request."))
+		return
+	}
+
 	username := ar.GetRequestForm().Get("username")
 	password := ar.GetRequestForm().Get("password")
+	if req.Method != http.MethodPost || username == "" {
+		s.renderLoginPage(rw, req, ar, username, "", http.StatusOK)
+		return
+	}
 
-	log.Info().Str("username", username).Err(err).Msg("login via password")
-	s.authorize(ctx, ar, rw, username, password)
+	log.Info().Str("username", username).Msg("login via password")
+	s.authorize(ctx, ar, rw, req, username, password)
 }
 
-func (s *Server) authorize(ctx context.Context, ar fosite.AuthorizeRequester, rw http.ResponseWriter, username, password string) {
+func (s *Server) authorize(ctx context.Context, ar fosite.AuthorizeRequester, rw http.ResponseWriter, req *http.Request, username, password string) {
 	log := zerolog.Ctx(ctx)
 	session := s.newSession(username, nil)
 
-	// Set subject to the session
-	usr, err := s.userSvc.GetUser(ctx, username)
+	err := s.authenticator.Authenticate(ctx, username, password)
 	if err != nil {
-		log.Error().Err(err).Msg("could not find account for subject")
-		http.Error(rw, "can't find account", http.StatusForbidden)
+		log.Error().Err(err).Str("username", username).Msg("could not authenticate user")
+		if errors.Is(err, ErrLoginThrottled) {
+			s.renderLoginPage(rw, req, ar, username, "Too many failed login attempts, try again later.", http.StatusTooManyRequests)
+			return
+		}
+		if errors.Is(err, types.ErrUnauthenticated) {
+			s.renderLoginPage(rw, req, ar, username, "Invalid username or password.", http.StatusUnauthorized)
+			return
+		}
+		s.renderLoginPage(rw, req, ar, username, "Authentication service is unavailable, try again later.", http.StatusServiceUnavailable)
 		return
 	}
-	if !usr.Enabled {
-		http.Error(rw, "access denied", http.StatusForbidden)
+	if err = s.checkMFA(ctx, username, ar.GetRequestForm().Get("otp")); err != nil {
+		log.Info().Err(err).Str("username", username).Msg("mfa check failed")
+		switch {
+		case errors.Is(err, ErrMFARequired):
+			s.renderLoginPage(rw, req, ar, username, "Enter one-time password from authenticator app or recovery code.", http.StatusUnauthorized)
+		case errors.Is(err, ErrMFAEnrollmentRequired):
+			s.renderLoginPage(rw, req, ar, username, "Multi-factor authentication is required for your account. Enroll it with /api/auth/mfa API first.", http.StatusUnauthorized)
+		case errors.Is(err, types.ErrUnauthenticated):
+			s.renderLoginPage(rw, req, ar, username, "Invalid one-time password.", http.StatusUnauthorized)
+		default:
+			s.renderLoginPage(rw, req, ar, username, "Authentication service is unavailable, try again later.", http.StatusServiceUnavailable)
+		}
 		return
 	}
-	err = bcrypt.CompareHashAndPassword([]byte(usr.Password), []byte(password))
+	s.limiter.succeeded(username)
+	usr, err := s.userSvc.GetUser(ctx, username)
 	if err != nil {
-		log.Error().Err(err).Msg("could not validate password")
-		http.Error(rw, "can't find account", http.StatusForbidden)
+		log.Error().Err(err).Msg("could not find account for subject")
+		s.renderLoginPage(rw, req, ar, username, "Invalid username or password.", http.StatusUnauthorized)
 		return
 	}
 
@@ -76,3 +113,19 @@ func (s *Server) authorize(ctx context.Context, ar fosite.AuthorizeRequester, rw
 	}
 	s.provider.WriteAuthorizeResponse(ctx, rw, ar, response)
 }
+
+// validatePKCE requires S256 code challenge from public clients.
+func validatePKCE(ar fosite.AuthorizeRequester) error {
+	form := ar.GetRequestForm()
+	challenge, method := form.Get("code_challenge"), form.Get("code_challenge_method")
+	if challenge == "" {
+		if ar.GetClient().IsPublic() {
+			return fosite.ErrInvalidRequest.WithHint("Clients must include a code_challenge when performing the authorize code flow, but it is missing.")
+		}
+		return nil
+	}
+	if method != "S256" {
+		return fosite.ErrInvalidRequest.WithHint("Only S256 code_challenge_method is supported.")
+	}
+	return nil
+}
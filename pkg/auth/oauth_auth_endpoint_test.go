@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/stretchr/testify/require"
+)
+
+func authorizeReq(srv *Server, method string, params url.Values) *httptest.ResponseRecorder {
+	var req *http.Request
+	if method == http.MethodGet {
+		req = httptest.NewRequest(method, "/api/oauth/auth?"+params.Encode(), nil)
+	} else {
+		req = httptest.NewRequest(method, "/api/oauth/auth", strings.NewReader(params.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	rec := httptest.NewRecorder()
+	srv.AuthEndpoint(rec, req)
+	return rec
+}
+
+func Test_AuthorizationCodePKCE(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	userSvc := newTestUserSvc(t)
+	srv := newTestServer(t, userSvc)
+	r.NoError(userSvc.CreateUser(ctx, user.User{Username: "alice", Password: "secret", Roles: []string{"read-only"}, Enabled: true}))
+
+	const redirectURI = "https://app.example.com/callback"
+	_, err := srv.CreateClient(ctx, OAuthClient{
+		ID:           "web",
+		Public:       true,
+		GrantTypes:   []string{GrantAuthorizationCode, GrantRefreshToken},
+		RedirectURIs: []string{redirectURI},
+	})
+	r.NoError(err)
+
+	verifier := strings.Repeat("v", 50)
+	sum := sha256.Sum256([]byte(verifier))
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {"web"},
+		"redirect_uri":          {redirectURI},
+		"scope":                 {"openid offline"},
+		"state":                 {"state-123456"},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(sum[:])},
+		"code_challenge_method": {"S256"},
+	}
+	with := func(kv ...string) url.Values {
+		res := url.Values{}
+		for k, v := range params {
+			res[k] = v
+		}
+		for i := 0; i < len(kv); i += 2 {
+			res.Set(kv[i], kv[i+1])
+		}
+		return res
+	}
+
+	// login page
+	rec := authorizeReq(srv, http.MethodGet, params)
+	r.EqualValues(http.StatusOK, rec.Code)
+	r.Contains(rec.Body.String(), `name="code_challenge"`)
+	r.Equal("DENY", rec.Header().Get("X-Frame-Options"))
+
+	// unknown redirect uri is not used for redirect
+	rec = authorizeReq(srv, http.MethodGet, with("redirect_uri", "https://evil.example.com/callback"))
+	r.EqualValues(http.StatusBadRequest, rec.Code)
+	r.Empty(rec.Header().Get("Location"))
+
+	// public client must use PKCE
+	noPKCE := with()
+	noPKCE.Del("code_challenge")
+	noPKCE.Del("code_challenge_method")
+	rec = authorizeReq(srv, http.MethodGet, noPKCE)
+	r.EqualValues(http.StatusSeeOther, rec.Code)
+	r.Contains(rec.Header().Get("Location"), "error=invalid_request")
+	rec = authorizeReq(srv, http.MethodGet, with("code_challenge_method", "plain"))
+	r.Contains(rec.Header().Get("Location"), "error=invalid_request")
+
+	// wrong password renders page again
+	rec = authorizeReq(srv, http.MethodPost, with("username", "alice", "password", "wrong"))
+	r.EqualValues(http.StatusUnauthorized, rec.Code)
+	r.Contains(rec.Body.String(), "Invalid username or password")
+
+	// deny
+	rec = authorizeReq(srv, http.MethodPost, with("deny", "true"))
+	r.EqualValues(http.StatusSeeOther, rec.Code)
+	r.Contains(rec.Header().Get("Location"), "error=access_denied")
+
+	// allow
+	rec = authorizeReq(srv, http.MethodPost, with("username", "alice", "password", "secret", "allow", "true"))
+	r.EqualValues(http.StatusSeeOther, rec.Code)
+	loc, err := url.Parse(rec.Header().Get("Location"))
+	r.NoError(err)
+	r.True(strings.HasPrefix(loc.String(), redirectURI))
+	r.Equal("state-123456", loc.Query().Get("state"))
+	code := loc.Query().Get("code")
+	r.NotEmpty(code)
+
+	exchange := url.Values{
+		"grant_type":    {GrantAuthorizationCode},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"client_id":     {"web"},
+		"code_verifier": {strings.Repeat("x", 50)},
+	}
+	status, _ := requestToken(srv, exchange, "web", "")
+	r.NotEqualValues(http.StatusOK, status, "wrong verifier")
+
+	// code is invalidated after failed exchange, get new one
+	rec = authorizeReq(srv, http.MethodPost, with("username", "alice", "password", "secret", "allow", "true"))
+	loc, err = url.Parse(rec.Header().Get("Location"))
+	r.NoError(err)
+	exchange.Set("code", loc.Query().Get("code"))
+	exchange.Set("code_verifier", verifier)
+	status, token := requestToken(srv, exchange, "web", "")
+	r.EqualValues(http.StatusOK, status)
+	r.NotEmpty(token)
+
+	authCtx, err := AuthFunc(userSvc, srv.Provider(), srv.GetPublicKey, srv.ClientRoles, nil, nil)(grpcCtx(token))
+	r.NoError(err)
+	r.NoError(user.HasPermissions(authCtx, user.ScopePool, user.PermRead))
+
+	// code cannot be reused
+	status, _ = requestToken(srv, exchange, "web", "")
+	r.NotEqualValues(http.StatusOK, status)
+}
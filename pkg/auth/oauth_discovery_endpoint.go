@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/rs/zerolog"
+)
+
+// JWKSEndpoint publishes public keys to verify issued tokens.
+func (s *Server) JWKSEndpoint(rw http.ResponseWriter, req *http.Request) {
+	keys := s.keys.all()
+	res := jose.JSONWebKeySet{Keys: make([]jose.JSONWebKey, 0, len(keys))}
+	for _, key := range keys {
+		res.Keys = append(res.Keys, jose.JSONWebKey{
+			Key:       &key.Key.PublicKey,
+			KeyID:     key.ID,
+			Algorithm: string(jose.RS256),
+			Use:       "sig",
+		})
+	}
+	writeJSON(rw, req, res)
+}
+
+// DiscoveryEndpoint serves OpenID Connect discovery document.
+func (s *Server) DiscoveryEndpoint(rw http.ResponseWriter, req *http.Request) {
+	baseURL := strings.TrimSuffix(s.publicURL, "/")
+	if baseURL == "" {
+		scheme := "http"
+		if req.TLS != nil {
+			scheme = "https"
+		}
+		if proto := req.Header.Get("X-Forwarded-Proto"); proto != "" {
+			scheme = proto
+		}
+		baseURL = scheme + "://" + req.Host
+	}
+	writeJSON(rw, req, map[string]any{
+		"issuer":                                s.issuer,
+		"authorization_endpoint":                baseURL + "/api/oauth/auth",
+		"token_endpoint":                        baseURL + "/api/oauth/token",
+		"revocation_endpoint":                   baseURL + "/api/oauth/revoke",
+		"introspection_endpoint":                baseURL + "/api/oauth/introspect",
+		"jwks_uri":                              baseURL + "/api/oauth/jwks",
+		"response_types_supported":              []string{"code"},
+		"grant_types_supported":                 supportedGrants,
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{string(jose.RS256)},
+		"scopes_supported":                      []string{"openid", "offline"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func writeJSON(rw http.ResponseWriter, req *http.Request, v any) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(v); err != nil {
+		zerolog.Ctx(req.Context()).Err(err).Msg("unable to write json response")
+	}
+}
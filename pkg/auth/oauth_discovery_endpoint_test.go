@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DiscoveryEndpoint(t *testing.T) {
+	r := require.New(t)
+	s := &Server{issuer: "https://ceph-api.example.com", publicURL: "https://ceph-api.example.com/"}
+	rec := httptest.NewRecorder()
+	s.DiscoveryEndpoint(rec, httptest.NewRequest("GET", "/.well-known/openid-configuration", nil))
+	var res struct {
+		TokenEndpoint  string   `json:"token_endpoint"`
+		ResponseTypes  []string `json:"response_types_supported"`
+		GrantTypes     []string `json:"grant_types_supported"`
+		ChallengeTypes []string `json:"code_challenge_methods_supported"`
+	}
+	r.NoError(json.Unmarshal(rec.Body.Bytes(), &res))
+	r.EqualValues("https://ceph-api.example.com/api/oauth/token", res.TokenEndpoint)
+	// only implemented flows are advertised
+	r.EqualValues([]string{"code"}, res.ResponseTypes)
+	r.ElementsMatch([]string{GrantAuthorizationCode, GrantRefreshToken, GrantPassword, GrantClientCredentials}, res.GrantTypes)
+	r.EqualValues([]string{"S256"}, res.ChallengeTypes)
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"html/template"
+	"net/http"
+	"sort"
+
+	"github.com/ory/fosite"
+	"github.com/rs/zerolog"
+)
+
+// loginPageParams - authorize request params which are not resubmitted from login form.
+var loginPageParams = map[string]bool{"username": true, "password": true, "otp": true, "allow": true, "deny": true}
+
+var loginPage = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Ceph API - Sign in</title>
+<style>
+body { font-family: sans-serif; background: #f4f5f7; display: flex; justify-content: center; padding-top: 10vh; }
+form { background: #fff; padding: 2em; border-radius: 6px; box-shadow: 0 1px 4px rgba(0,0,0,.2); width: 22em; }
+label, input { display: block; width: 100%; box-sizing: border-box; }
+input { margin: .3em 0 1em; padding: .5em; }
+.error { color: #b00020; }
+.buttons { display: flex; gap: 1em; }
+.buttons button { flex: 1; padding: .6em; }
+</style>
+</head>
+<body>
+<form method="post" action="{{.Action}}">
+<h2>Sign in to Ceph API</h2>
+<p>Application <b>{{.ClientID}}</b> requests access{{if .Scopes}} with scopes: {{range $i, $s := .Scopes}}{{if $i}}, {{end}}<code>{{$s}}</code>{{end}}{{end}}.</p>
+{{if .Error}}<p class="error">{{.Error}}</p>{{end}}
+{{range .Hidden}}<input type="hidden" name="{{.Name}}" value="{{.Value}}">
+{{end}}<label for="username">Username</label>
+<input id="username" name="username" autocomplete="username" value="{{.Username}}" required autofocus>
+<label for="password">Password</label>
+<input id="password" name="password" type="password" autocomplete="current-password" required>
+<label for="otp">One-time password (if MFA is enabled)</label>
+<input id="otp" name="otp" autocomplete="one-time-code" inputmode="numeric">
+<div class="buttons">
+<button type="submit" name="allow" value="true">Allow</button>
+<button type="submit" name="deny" value="true" formnovalidate>Deny</button>
+</div>
+</form>
+</body>
+</html>
+`))
+
+type hiddenField struct {
+	Name, Value string
+}
+
+// renderLoginPage shows login and consent form. Form resubmits authorize request params to AuthEndpoint.
+func (s *Server) renderLoginPage(rw http.ResponseWriter, req *http.Request, ar fosite.AuthorizeRequester, username, errMsg string, status int) {
+	form := ar.GetRequestForm()
+	names := make([]string, 0, len(form))
+	for name := range form {
+		if !loginPageParams[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	hidden := make([]hiddenField, 0, len(names))
+	for _, name := range names {
+		for _, val := range form[name] {
+			hidden = append(hidden, hiddenField{Name: name, Value: val})
+		}
+	}
+	rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+	rw.Header().Set("Cache-Control", "no-store")
+	// forbid rendering in frames to prevent clickjacking
+	rw.Header().Set("X-Frame-Options", "DENY")
+	rw.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; frame-ancestors 'none'")
+	rw.WriteHeader(status)
+	err := loginPage.Execute(rw, map[string]any{
+		"Action":   req.URL.Path,
+		"ClientID": ar.GetClient().GetID(),
+		"Scopes":   ar.GetRequestedScopes(),
+		"Hidden":   hidden,
+		"Username": username,
+		"Error":    errMsg,
+	})
+	if err != nil {
+		zerolog.Ctx(req.Context()).Err(err).Msg("unable to render login page")
+	}
+}
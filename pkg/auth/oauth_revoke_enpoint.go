@@ -2,14 +2,26 @@ package auth
 
 import (
 	"net/http"
+
+	"github.com/clyso/ceph-api/pkg/audit"
+	"github.com/ory/fosite"
+	"google.golang.org/grpc/codes"
 )
 
 func (s *Server) RevokeEndpoint(rw http.ResponseWriter, req *http.Request) {
 	// This context will be passed to all methods.
-	ctx := req.Context()
+	ctx := withRequestSourceIP(req)
 
 	// This will accept the token revocation request and validate various parameters.
 	err := s.provider.NewRevocationRequest(ctx, req)
+	event := audit.Event{Event: "token_revoked", Code: codes.OK.String()}
+	if clientID, _, ok := req.BasicAuth(); ok {
+		event.Resources = map[string]string{"client_id": clientID}
+	}
+	if err != nil {
+		event.Code, event.Reason = codes.InvalidArgument.String(), fosite.ErrorToRFC6749Error(err).ErrorField
+	}
+	s.auditLog.Record(ctx, event)
 
 	// All done, send the response.
 	s.provider.WriteRevocationResponse(ctx, rw, err)
@@ -2,81 +2,170 @@ package auth
 
 import (
 	"context"
+	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/clyso/ceph-api/pkg/audit"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
 	"github.com/clyso/ceph-api/pkg/user"
 	"github.com/ory/fosite"
 	"github.com/ory/fosite/compose"
 	"github.com/ory/fosite/handler/oauth2"
+	"github.com/ory/fosite/handler/openid"
 	"github.com/ory/fosite/storage"
 	"github.com/ory/fosite/token/jwt"
-	"golang.org/x/crypto/bcrypt"
 )
 
 type Server struct {
-	publicKey            *rsa.PublicKey
-	keyID                string
+	keys *keySet
+	// keyStore is nil if signing keys are provided in file
+	keyStore            kvStore
+	keyRotationInterval time.Duration
+	keyRetention        time.Duration
+
 	issuer               string
+	publicURL            string
 	clientID             string
 	refreshTokenLifespan time.Duration
 
 	provider fosite.OAuth2Provider
 	storage  fosite.Storage
+	tokens   *tokenStore
+	clients  *clientRegistry
 
 	authorizeCodeStrategy oauth2.AuthorizeCodeStrategy
 	refreshTokenStrategy  oauth2.RefreshTokenStrategy
 	accessTokenStrategy   oauth2.AccessTokenStrategy
 
-	userSvc *user.Service
+	userSvc       *user.Service
+	authenticator Authenticator
+	mfaConf       MFAConfig
+	mfaCipher     cipher.AEAD
+	backupKey     []byte
+	limiter       *loginLimiter
+	auditLog      *audit.Log
 }
 
-func NewServer(config Config, userSvc *user.Service) (*Server, error) {
-	var secret = make([]byte, 32)
-	_, err := rand.Read(secret)
+// authorizeCodeLifespan - how long authorization code issued by login page can be exchanged for token.
+const authorizeCodeLifespan = 10 * time.Minute
+
+func NewServer(config Config, userSvc *user.Service, radosSvc *rados.Svc, auditLog *audit.Log) (*Server, error) {
+	ctx := context.Background()
+	// keys are always persisted in Ceph unless provided in files
+	var keyStore kvStore = &cephKV{radosSvc: radosSvc}
+	secret, err := loadSecret(ctx, config, keyStore)
 	if err != nil {
 		return nil, err
 	}
-
-	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	signingKeys, err := loadSigningKeys(ctx, config, keyStore)
 	if err != nil {
 		return nil, err
 	}
 
+	var tokenKV kvStore
+	switch config.TokenStore {
+	case TokenStoreCeph:
+		tokenKV = keyStore
+	case TokenStoreMemory:
+		tokenKV = newMemKV()
+	default:
+		return nil, fmt.Errorf("%w: unknown oauth token store %q", types.ErrInvalidConfig, config.TokenStore)
+	}
+
 	defaultStor := storage.NewMemoryStore()
-	defaultStor.Clients = map[string]fosite.Client{
-		config.ClientID: &fosite.DefaultClient{
-			ID:            config.ClientID,
-			Public:        true,
-			ResponseTypes: []string{"id_token", "code", "token", "id_token token", "code id_token", "code token", "code id_token token"},
-			GrantTypes:    []string{"refresh_token", "password"},
-			Scopes:        []string{"openid", "offline"},
-		}}
+	builtinClient := &fosite.DefaultClient{
+		ID:            config.ClientID,
+		Public:        true,
+		ResponseTypes: []string{"id_token", "code", "token", "id_token token", "code id_token", "code token", "code id_token token"},
+		GrantTypes:    []string{"refresh_token", "password"},
+		Scopes:        []string{"openid", "offline"},
+	}
 
+	res := &Server{
+		keys:                &keySet{},
+		keyRotationInterval: config.KeyRotationInterval,
+		// keep retired keys until tokens signed with them are expired
+		keyRetention:         max(config.AccessTokenLifespan, time.Hour),
+		issuer:               config.Issuer,
+		publicURL:            config.PublicURL,
+		clientID:             config.ClientID,
+		refreshTokenLifespan: config.RefreshTokenLifespan,
+		userSvc:              userSvc,
+		auditLog:             auditLog,
+	}
+	res.keys.set(signingKeys)
+	if config.SigningKeyFile == "" {
+		res.keyStore = keyStore
+	}
+	res.tokens = newTokenStore(defaultStor, tokenKV, func() fosite.Session {
+		return res.newSession("", nil)
+	}, config)
+	// clients are always persisted in Ceph to be shared between API replicas
+	res.clients = newClientRegistry(keyStore, builtinClient, userSvc)
+	res.tokens.clients = res.clients
+	authenticator, err := newAuthenticator(config, userSvc)
+	if err != nil {
+		return nil, err
+	}
+	res.limiter = newLoginLimiter(config.Lockout, userSvc, auditLog)
+	authenticator = &throttledAuthenticator{next: authenticator, limiter: res.limiter}
+	res.authenticator = authenticator
+	res.mfaConf = config.MFA
+	if res.mfaConf.Issuer == "" {
+		res.mfaConf.Issuer = config.Issuer
+	}
+	mfaKey, err := loadMFAKey(ctx, config, secret)
+	if err != nil {
+		return nil, err
+	}
+	if res.mfaCipher, err = newMFACipher(mfaKey); err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("ceph-api access db backup signature"))
+	res.backupKey = mac.Sum(nil)
 	storage := &fositeStore{
-		userSvc:     userSvc,
-		MemoryStore: defaultStor,
+		authenticator: authenticator,
+		tokenStore:    res.tokens,
 	}
 
 	conf := &fosite.Config{
 		GlobalSecret:  secret,
 		ScopeStrategy: fosite.HierarchicScopeStrategy,
 		// Allow all grants to get refresh token
-		RefreshTokenScopes:   []string{},
-		AccessTokenLifespan:  config.AccessTokenLifespan,
-		RefreshTokenLifespan: config.RefreshTokenLifespan,
+		RefreshTokenScopes:    []string{},
+		AccessTokenLifespan:   config.AccessTokenLifespan,
+		RefreshTokenLifespan:  config.RefreshTokenLifespan,
+		AuthorizeCodeLifespan: authorizeCodeLifespan,
+		// public clients cannot keep secret, so authorization code must be bound to PKCE verifier
+		EnforcePKCEForPublicClients:    true,
+		EnablePKCEPlainChallengeMethod: false,
 	}
 
-	pGetter := func(ctx context.Context) (any, error) { return privateKey, nil }
+	signer := &keySigner{keys: res.keys}
+	res.tokens.signer = signer
 	strategy := &compose.CommonStrategy{
 		// Override default strategy to issue JWT instead of HMAC tokens
-		CoreStrategy:               compose.NewOAuth2JWTStrategy(pGetter, compose.NewOAuth2HMACStrategy(conf), conf),
-		OpenIDConnectTokenStrategy: compose.NewOpenIDConnectStrategy(pGetter, conf),
-		Signer:                     &jwt.DefaultSigner{GetPrivateKey: pGetter}}
+		CoreStrategy: &statelessJWTStrategy{DefaultJWTStrategy: &oauth2.DefaultJWTStrategy{
+			Signer:          signer,
+			HMACSHAStrategy: compose.NewOAuth2HMACStrategy(conf),
+			Config:          conf,
+		}},
+		OpenIDConnectTokenStrategy: &openid.DefaultStrategy{Signer: signer, Config: conf},
+		Signer:                     signer,
+	}
 
 	oauth2Provider := compose.Compose(conf, storage, strategy,
 		compose.OAuth2AuthorizeExplicitFactory,
+		compose.OAuth2PKCEFactory,
 		compose.OAuth2AuthorizeImplicitFactory,
 		compose.OAuth2ClientCredentialsGrantFactory,
 		compose.OAuth2RefreshTokenGrantFactory,
@@ -86,32 +175,39 @@ func NewServer(config Config, userSvc *user.Service) (*Server, error) {
 		compose.OAuth2TokenRevocationFactory,
 	)
 
-	return &Server{
-		publicKey:             &privateKey.PublicKey,
-		keyID:                 "0",
-		issuer:                config.Issuer,
-		clientID:              config.ClientID,
-		refreshTokenLifespan:  config.RefreshTokenLifespan,
-		provider:              oauth2Provider,
-		storage:               storage,
-		authorizeCodeStrategy: strategy,
-		refreshTokenStrategy:  strategy,
-		accessTokenStrategy:   strategy,
-		userSvc:               userSvc,
-	}, nil
+	res.provider = oauth2Provider
+	res.storage = storage
+	res.authorizeCodeStrategy = strategy
+	res.refreshTokenStrategy = strategy
+	res.accessTokenStrategy = strategy
+	return res, nil
+}
+
+// CleanupTokens periodically deletes expired tokens from token store. Blocks until ctx is done.
+func (s *Server) CleanupTokens(ctx context.Context) error {
+	return s.tokens.Cleanup(ctx)
+}
+
+// BackupKey returns key to sign access DB backups. It is derived from OAuth secret,
+// so backups can be verified by all API replicas sharing the secret.
+func (s *Server) BackupKey() []byte {
+	return s.backupKey
 }
 
 func (s *Server) Provider() fosite.OAuth2Provider {
 	return s.provider
 }
 
-func (s *Server) GetPublicKey() *rsa.PublicKey {
-	return s.publicKey
+// GetPublicKey returns public key to verify token signed with given key id.
+func (s *Server) GetPublicKey(kid string) (*rsa.PublicKey, error) {
+	key, err := s.keys.get(kid)
+	if err != nil {
+		return nil, err
+	}
+	return &key.Key.PublicKey, nil
 }
 
 func (s *Server) newSession(subject string, claims map[string]interface{}) oauth2.JWTSessionContainer {
-	jwtHeaders := make(map[string]interface{})
-	jwtHeaders["kid"] = s.keyID
 	return &oauth2.JWTSession{
 		JWTClaims: &jwt.JWTClaims{
 			Issuer:    s.issuer,
@@ -120,29 +216,67 @@ func (s *Server) newSession(subject string, claims map[string]interface{}) oauth
 			IssuedAt:  time.Now(),
 			Extra:     claims,
 		},
+		// key id is set by signer
 		JWTHeader: &jwt.Headers{
-			Extra: jwtHeaders,
+			Extra: map[string]interface{}{},
 		},
 	}
 }
 
-type fositeStore struct {
-	userSvc *user.Service
-	*storage.MemoryStore
+// statelessJWTStrategy issues JWT access tokens which are not stored. The whole token is used as its signature,
+// so token store verifies it and restores request from token claims.
+type statelessJWTStrategy struct {
+	*oauth2.DefaultJWTStrategy
 }
 
-func (s *fositeStore) Authenticate(ctx context.Context, name string, secret string) error {
+func (s *statelessJWTStrategy) AccessTokenSignature(_ context.Context, token string) string {
+	return token
+}
 
-	usr, err := s.userSvc.GetUser(ctx, name)
-	if err != nil {
-		return fosite.ErrNotFound
+func (s *statelessJWTStrategy) GenerateAccessToken(ctx context.Context, req fosite.Requester) (string, string, error) {
+	session, ok := req.GetSession().(*oauth2.JWTSession)
+	if !ok || session.JWTClaims == nil {
+		return "", "", fmt.Errorf("unexpected session type %T", req.GetSession())
 	}
-	if !usr.Enabled {
-		return fosite.ErrNotFound.WithDebug("User disabled")
+	if session.JWTClaims.JTI == "" {
+		jti, err := newTokenID()
+		if err != nil {
+			return "", "", err
+		}
+		session.JWTClaims.JTI = jti
 	}
-	err = bcrypt.CompareHashAndPassword([]byte(usr.Password), []byte(secret))
-	if err != nil {
-		return fosite.ErrNotFound.WithDebug("Invalid Credentials")
+	if session.JWTClaims.Extra == nil {
+		session.JWTClaims.Extra = map[string]interface{}{}
+	}
+	session.JWTClaims.Extra[clientIDClaim] = req.GetClient().GetID()
+	session.JWTClaims.Extra[grantTypeClaim] = req.GetRequestForm().Get("grant_type")
+	if session.JWTHeader == nil {
+		session.JWTHeader = &jwt.Headers{}
+	}
+	// distinguishes access tokens from ID tokens signed with the same key
+	session.JWTHeader.Add("typ", accessTokenType)
+	token, _, err := s.DefaultJWTStrategy.GenerateAccessToken(ctx, req)
+	return token, token, err
+}
+
+// newTokenID returns random access token ID ("jti" claim).
+func newTokenID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
+
+type fositeStore struct {
+	authenticator Authenticator
+	*tokenStore
+}
+
+func (s *fositeStore) Authenticate(ctx context.Context, name string, secret string) error {
+	err := s.authenticator.Authenticate(ctx, name, secret)
+	if errors.Is(err, types.ErrUnauthenticated) {
+		return fosite.ErrNotFound.WithDebug(err.Error())
 	}
-	return nil
+	return err
 }
@@ -1,16 +1,20 @@
 package auth
 
 import (
-	"fmt"
+	"errors"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/clyso/ceph-api/pkg/audit"
 	"github.com/ory/fosite"
 	"github.com/ory/fosite/handler/oauth2"
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
 )
 
 func (s *Server) TokenEndpoint(rw http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
+	ctx := withRequestSourceIP(req)
 	logger := zerolog.Ctx(ctx)
 
 	// Create an empty session object that will be passed to storage implementation to populate (unmarshal) the session into.
@@ -30,11 +34,35 @@ func (s *Server) TokenEndpoint(rw http.ResponseWriter, req *http.Request) {
 		if !fositeError.Is(fosite.ErrInvalidGrant) {
 			logger.Debug().Str("error_description", fositeError.GetDescription()).Err(err).Msg("error occurred in NewAccessRequest")
 		}
+		if errors.Is(err, ErrLoginThrottled) {
+			err = errLoginThrottledGrant
+		}
 		s.provider.WriteAccessError(ctx, rw, accessRequest, err)
 		return
 	}
 
-	username := sessionData.GetUsername()
+	// second factor is checked after password, so MFA state is not disclosed without valid credentials
+	if accessRequest.GetGrantTypes().ExactOne(GrantPassword) {
+		form := accessRequest.GetRequestForm()
+		if err = s.checkMFA(ctx, form.Get("username"), form.Get("otp")); err != nil {
+			logger.Info().Err(err).Str("username", form.Get("username")).Msg("mfa check failed")
+			s.provider.WriteAccessError(ctx, rw, accessRequest, mfaGrantError(err))
+			return
+		}
+		s.limiter.succeeded(form.Get("username"))
+	}
+
+	session := accessRequest.GetSession().(*oauth2.JWTSession)
+	// machine-to-machine token is issued for client without user account
+	if accessRequest.GetGrantTypes().ExactOne(GrantClientCredentials) {
+		session.JWTClaims.Subject = accessRequest.GetClient().GetID()
+		session.Subject = accessRequest.GetClient().GetID()
+		s.writeAccessResponse(rw, req, accessRequest, nil)
+		return
+	}
+
+	// refresh token grant restores session of original request
+	username := accessRequest.GetSession().GetSubject()
 	if username == "" {
 		username = accessRequest.GetRequestForm().Get("username")
 	}
@@ -47,16 +75,33 @@ func (s *Server) TokenEndpoint(rw http.ResponseWriter, req *http.Request) {
 		return
 	}
 	if !usr.Enabled {
-		err = fmt.Errorf("inactive account")
-		fositeError := fosite.ErrorToRFC6749Error(err)
-		logger.Error().Str("error_description", fositeError.GetDescription()).Err(err).Msg("account is inactive")
-		s.provider.WriteAccessError(ctx, rw, accessRequest, err)
+		logger.Info().Str("username", usr.Username).Msg("account is inactive")
+		s.provider.WriteAccessError(ctx, rw, accessRequest, fosite.ErrInvalidGrant.WithHint("User account is disabled."))
 		return
 	}
-	session := accessRequest.GetSession().(*oauth2.JWTSession)
 	// Set token subject as login
 	session.JWTClaims.Subject = usr.Username
 	session.Subject = usr.Username
+	// token is issued, but can be used only to change password
+	s.writeAccessResponse(rw, req, accessRequest, map[string]any{
+		"pwd_update_required": usr.PasswordChangeRequired(time.Now()),
+	})
+}
+
+// writeAccessResponse issues tokens and writes them with given extra response fields.
+func (s *Server) writeAccessResponse(rw http.ResponseWriter, req *http.Request, accessRequest fosite.AccessRequester, extra map[string]any) {
+	ctx := withRequestSourceIP(req)
+	logger := zerolog.Ctx(ctx)
+
+	// access token ID is set before tokens are issued, because refresh token session is stored with the ID of access token
+	// issued with it and some grants store refresh token first
+	tokenID, err := newTokenID()
+	if err != nil {
+		logger.Error().Err(err).Msg("can't generate access token id")
+		s.provider.WriteAccessError(ctx, rw, accessRequest, err)
+		return
+	}
+	accessRequest.GetSession().(*oauth2.JWTSession).JWTClaims.JTI = tokenID
 
 	// Next we create a response for the access request. Again, we iterate through the TokenEndpointHandlers
 	// and aggregate the result in response.
@@ -70,7 +115,19 @@ func (s *Server) TokenEndpoint(rw http.ResponseWriter, req *http.Request) {
 
 	// Set refresh token lifespan in token response
 	response.SetExtra("refresh_expires_in", s.refreshTokenLifespan.Seconds())
+	for k, v := range extra {
+		response.SetExtra(k, v)
+	}
 
+	s.auditLog.Record(ctx, audit.Event{
+		Event:    "token_issued",
+		Username: accessRequest.GetSession().GetSubject(),
+		Resources: map[string]string{
+			"client_id":  accessRequest.GetClient().GetID(),
+			"grant_type": strings.Join(accessRequest.GetGrantTypes(), ","),
+		},
+		Code: codes.OK.String(),
+	})
 	// All done, send the response.
 	s.provider.WriteAccessResponse(ctx, rw, accessRequest, response)
 }
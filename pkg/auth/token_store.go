@@ -0,0 +1,512 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/ory/fosite"
+	"github.com/ory/fosite/handler/oauth2"
+	"github.com/ory/fosite/storage"
+	"github.com/ory/fosite/token/jwt"
+	"github.com/rs/zerolog"
+)
+
+const (
+	tokenKeyPrefix = "ceph-api/oauth/token/"
+	// requestKeyPrefix - prefix of request index entries "<prefix><request id>/<token type>/<token hash>" with token expiration time.
+	// Each token has its own entry, so replicas never update the same index key.
+	requestKeyPrefix = "ceph-api/oauth/request/"
+	// revokedKeyPrefix - prefix of revoked access token IDs with token expiration time.
+	revokedKeyPrefix = "ceph-api/oauth/revoked/"
+
+	// clientIDClaim and grantTypeClaim - access token claims with client and grant type of token request.
+	clientIDClaim  = "client_id"
+	grantTypeClaim = "gty"
+	// accessTokenType - JWT "typ" header of access tokens, see RFC 9068.
+	accessTokenType = "at+jwt"
+)
+
+// storedFormFields - request form fields persisted with token. Secrets are never stored.
+// Redirect URI and PKCE challenge are checked when authorization code is exchanged for token.
+var storedFormFields = []string{"grant_type", "username", "scope", "client_id", "redirect_uri", "code_challenge", "code_challenge_method"}
+
+// pkceSession - token type of PKCE request sessions stored for authorization codes.
+const pkceSession fosite.TokenType = "pkce"
+
+// tokenStore persists refresh token sessions, authorization codes and access token revocations in kvStore, so tokens
+// survive restarts and can be exchanged and revoked by any API replica. Other fosite storage methods are served by embedded MemoryStore.
+// Each token is stored with two writes: token and its request index entry.
+// Access tokens are not stored: they are verified with signing keys and checked against the list of revoked token IDs.
+type tokenStore struct {
+	*storage.MemoryStore
+	// clients overrides MemoryStore clients if set
+	clients    clientGetter
+	signer     jwt.Signer
+	kv         kvStore
+	newSession func() fosite.Session
+	lifespans  map[fosite.TokenType]time.Duration
+	// cacheTTL - how long revoked access token IDs are cached locally. Revocations made by other replicas are visible after cacheTTL.
+	cacheTTL time.Duration
+
+	revokedMu sync.Mutex
+	// revoked - expiration time of revoked access tokens by token ID.
+	revoked       map[string]int64
+	revokedLoaded time.Time
+}
+
+// storedToken - serialized fosite.Request.
+type storedToken struct {
+	RequestID         string          `json:"request_id"`
+	RequestedAt       time.Time       `json:"requested_at"`
+	ClientID          string          `json:"client_id"`
+	RequestedScope    []string        `json:"requested_scope"`
+	GrantedScope      []string        `json:"granted_scope"`
+	RequestedAudience []string        `json:"requested_audience"`
+	GrantedAudience   []string        `json:"granted_audience"`
+	Form              url.Values      `json:"form"`
+	Session           json.RawMessage `json:"session"`
+	Active            bool            `json:"active"`
+	ExpiresAt         time.Time       `json:"expires_at"`
+}
+
+func newTokenStore(mem *storage.MemoryStore, kv kvStore, newSession func() fosite.Session, conf Config) *tokenStore {
+	return &tokenStore{
+		MemoryStore: mem,
+		kv:          kv,
+		newSession:  newSession,
+		lifespans: map[fosite.TokenType]time.Duration{
+			fosite.AccessToken:   conf.AccessTokenLifespan,
+			fosite.RefreshToken:  conf.RefreshTokenLifespan,
+			fosite.AuthorizeCode: authorizeCodeLifespan,
+			pkceSession:          authorizeCodeLifespan,
+		},
+		cacheTTL: conf.TokenCacheTTL,
+	}
+}
+
+type clientGetter interface {
+	GetClient(ctx context.Context, id string) (fosite.Client, error)
+}
+
+func (s *tokenStore) GetClient(ctx context.Context, id string) (fosite.Client, error) {
+	if s.clients != nil {
+		return s.clients.GetClient(ctx, id)
+	}
+	return s.MemoryStore.GetClient(ctx, id)
+}
+
+func tokenKey(tokenType fosite.TokenType, signature string) string {
+	hash := sha256.Sum256([]byte(signature))
+	return tokenKeyPrefix + string(tokenType) + "/" + hex.EncodeToString(hash[:])
+}
+
+// indexKey returns request index entry of token key.
+func indexKey(requestID, tokenKey string) string {
+	return requestKeyPrefix + requestID + "/" + strings.TrimPrefix(tokenKey, tokenKeyPrefix)
+}
+
+// indexedTokenKey returns token key and type of request index entry.
+func indexedTokenKey(indexKey string) (string, fosite.TokenType, bool) {
+	_, tokenPath, ok := strings.Cut(strings.TrimPrefix(indexKey, requestKeyPrefix), "/")
+	if !ok {
+		return "", "", false
+	}
+	tokenType, _, ok := strings.Cut(tokenPath, "/")
+	return tokenKeyPrefix + tokenPath, fosite.TokenType(tokenType), ok
+}
+
+// CreateAccessTokenSession does nothing: access token is a signed JWT with all request data needed to validate it.
+func (s *tokenStore) CreateAccessTokenSession(context.Context, string, fosite.Requester) error {
+	return nil
+}
+
+// GetAccessTokenSession verifies access token and restores its request from token claims.
+// Signature is the whole token, see statelessJWTStrategy. Token ID is used as request ID.
+func (s *tokenStore) GetAccessTokenSession(ctx context.Context, signature string, session fosite.Session) (fosite.Requester, error) {
+	token, err := s.decodeAccessToken(ctx, signature)
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := s.isRevoked(ctx, token.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, fosite.ErrInactiveToken
+	}
+	return s.toRequest(ctx, token, session)
+}
+
+// DeleteAccessTokenSession revokes access token.
+func (s *tokenStore) DeleteAccessTokenSession(ctx context.Context, signature string) error {
+	token, err := s.decodeAccessToken(ctx, signature)
+	if err != nil {
+		return err
+	}
+	return s.revoke(ctx, token.RequestID, token.ExpiresAt)
+}
+
+func (s *tokenStore) CreateRefreshTokenSession(ctx context.Context, signature string, req fosite.Requester) error {
+	return s.create(ctx, fosite.RefreshToken, signature, req)
+}
+
+func (s *tokenStore) GetRefreshTokenSession(ctx context.Context, signature string, session fosite.Session) (fosite.Requester, error) {
+	// refresh tokens are not cached to detect reuse of rotated tokens on other replicas
+	token, err := s.get(ctx, tokenKey(fosite.RefreshToken, signature))
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.toRequest(ctx, token, session)
+	if err != nil {
+		return nil, err
+	}
+	if !token.Active {
+		return req, fosite.ErrInactiveToken
+	}
+	return req, nil
+}
+
+func (s *tokenStore) DeleteRefreshTokenSession(ctx context.Context, signature string) error {
+	return s.delete(ctx, tokenKey(fosite.RefreshToken, signature))
+}
+
+func (s *tokenStore) CreateAuthorizeCodeSession(ctx context.Context, code string, req fosite.Requester) error {
+	return s.create(ctx, fosite.AuthorizeCode, code, req)
+}
+
+func (s *tokenStore) GetAuthorizeCodeSession(ctx context.Context, code string, session fosite.Session) (fosite.Requester, error) {
+	token, err := s.get(ctx, tokenKey(fosite.AuthorizeCode, code))
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.toRequest(ctx, token, session)
+	if err != nil {
+		return nil, err
+	}
+	// fosite revokes tokens issued for reused code
+	if !token.Active {
+		return req, fosite.ErrInvalidatedAuthorizeCode
+	}
+	return req, nil
+}
+
+func (s *tokenStore) InvalidateAuthorizeCodeSession(ctx context.Context, code string) error {
+	key := tokenKey(fosite.AuthorizeCode, code)
+	token, err := s.get(ctx, key)
+	if err != nil {
+		return err
+	}
+	token.Active = false
+	return s.put(ctx, key, token)
+}
+
+func (s *tokenStore) CreatePKCERequestSession(ctx context.Context, code string, req fosite.Requester) error {
+	return s.create(ctx, pkceSession, code, req)
+}
+
+func (s *tokenStore) GetPKCERequestSession(ctx context.Context, code string, session fosite.Session) (fosite.Requester, error) {
+	token, err := s.get(ctx, tokenKey(pkceSession, code))
+	if err != nil {
+		return nil, err
+	}
+	return s.toRequest(ctx, token, session)
+}
+
+func (s *tokenStore) DeletePKCERequestSession(ctx context.Context, code string) error {
+	return s.delete(ctx, tokenKey(pkceSession, code))
+}
+
+func (s *tokenStore) RevokeRefreshToken(ctx context.Context, requestID string) error {
+	keys, err := s.refreshTokenKeys(ctx, requestID)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		token, err := s.get(ctx, key)
+		if errors.Is(err, fosite.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		token.Active = false
+		if err = s.put(ctx, key, token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *tokenStore) RevokeRefreshTokenMaybeGracePeriod(ctx context.Context, requestID string, _ string) error {
+	return s.RevokeRefreshToken(ctx, requestID)
+}
+
+// RevokeAccessToken revokes not expired access tokens issued with refresh tokens of given request.
+// Request restored from access token has token ID as request ID, so the token itself is revoked if request has no refresh tokens.
+func (s *tokenStore) RevokeAccessToken(ctx context.Context, requestID string) error {
+	keys, err := s.refreshTokenKeys(ctx, requestID)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return s.revoke(ctx, requestID, time.Now().Add(s.lifespans[fosite.AccessToken]))
+	}
+	for _, key := range keys {
+		token, err := s.get(ctx, key)
+		if errors.Is(err, fosite.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		var session oauth2.JWTSession
+		if err = json.Unmarshal(token.Session, &session); err != nil {
+			return err
+		}
+		if session.JWTClaims == nil || session.JWTClaims.JTI == "" {
+			continue
+		}
+		expiresAt := session.GetExpiresAt(fosite.AccessToken)
+		if !expiresAt.IsZero() && expiresAt.Before(time.Now()) {
+			continue
+		}
+		if err = s.revoke(ctx, session.JWTClaims.JTI, expiresAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Cleanup periodically deletes expired tokens from store. Blocks until ctx is done.
+func (s *tokenStore) Cleanup(ctx context.Context) error {
+	interval := s.lifespans[fosite.RefreshToken]
+	if interval <= 0 || interval > time.Hour {
+		interval = time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := s.deleteExpired(ctx); err != nil {
+			zerolog.Ctx(ctx).Err(err).Msg("unable to delete expired oauth tokens")
+		}
+	}
+}
+
+func (s *tokenStore) deleteExpired(ctx context.Context) error {
+	entries, err := s.kv.List(ctx, requestKeyPrefix)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	revoked, err := s.kv.List(ctx, revokedKeyPrefix)
+	if err != nil {
+		return err
+	}
+	for key, val := range revoked {
+		expiresAt, err := strconv.ParseInt(string(val), 10, 64)
+		if err == nil && time.Unix(expiresAt, 0).After(now) {
+			continue
+		}
+		if err = s.kv.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	for key, val := range entries {
+		expiresAt, err := strconv.ParseInt(string(val), 10, 64)
+		if err == nil && time.Unix(expiresAt, 0).After(now) {
+			continue
+		}
+		if tokenKey, _, ok := indexedTokenKey(key); ok {
+			if err = s.delete(ctx, tokenKey); err != nil {
+				return err
+			}
+		}
+		if err = s.kv.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *tokenStore) create(ctx context.Context, tokenType fosite.TokenType, signature string, req fosite.Requester) error {
+	session, err := json.Marshal(req.GetSession())
+	if err != nil {
+		return err
+	}
+	form := url.Values{}
+	for _, field := range storedFormFields {
+		if val, ok := req.GetRequestForm()[field]; ok {
+			form[field] = val
+		}
+	}
+	expiresAt := req.GetSession().GetExpiresAt(tokenType)
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(s.lifespans[tokenType])
+	}
+	token := storedToken{
+		RequestID:         req.GetID(),
+		RequestedAt:       req.GetRequestedAt(),
+		ClientID:          req.GetClient().GetID(),
+		RequestedScope:    req.GetRequestedScopes(),
+		GrantedScope:      req.GetGrantedScopes(),
+		RequestedAudience: req.GetRequestedAudience(),
+		GrantedAudience:   req.GetGrantedAudience(),
+		Form:              form,
+		Session:           session,
+		Active:            true,
+		ExpiresAt:         expiresAt,
+	}
+	key := tokenKey(tokenType, signature)
+	// index entry is written first, so stored token is always found by cleanup
+	if err = s.kv.Set(ctx, indexKey(req.GetID(), key), []byte(strconv.FormatInt(expiresAt.Unix(), 10))); err != nil {
+		return err
+	}
+	return s.put(ctx, key, token)
+}
+
+// decodeAccessToken verifies access token and returns it as stored token.
+func (s *tokenStore) decodeAccessToken(ctx context.Context, token string) (storedToken, error) {
+	var res storedToken
+	decoded, err := s.signer.Decode(ctx, token)
+	if err != nil || !decoded.Valid() {
+		return res, fosite.ErrNotFound.WithWrap(err)
+	}
+	if typ, _ := decoded.Header["typ"].(string); typ != accessTokenType {
+		return res, fosite.ErrNotFound.WithDebug("not an access token")
+	}
+	req := oauth2.AccessTokenJWTToRequest(decoded)
+	session, err := json.Marshal(req.GetSession())
+	if err != nil {
+		return res, err
+	}
+	grantType, _ := decoded.Claims[grantTypeClaim].(string)
+	jti, _ := decoded.Claims["jti"].(string)
+	return storedToken{
+		RequestID:         jti,
+		RequestedAt:       req.GetRequestedAt(),
+		ClientID:          req.GetClient().GetID(),
+		RequestedScope:    req.GetRequestedScopes(),
+		GrantedScope:      req.GetGrantedScopes(),
+		RequestedAudience: req.GetRequestedAudience(),
+		GrantedAudience:   req.GetGrantedAudience(),
+		Form:              url.Values{"grant_type": {grantType}},
+		Session:           session,
+		Active:            true,
+		ExpiresAt:         req.GetSession().GetExpiresAt(fosite.AccessToken),
+	}, nil
+}
+
+func (s *tokenStore) toRequest(ctx context.Context, token storedToken, session fosite.Session) (fosite.Requester, error) {
+	if session == nil {
+		session = s.newSession()
+	} else {
+		session = session.Clone()
+	}
+	if err := json.Unmarshal(token.Session, session); err != nil {
+		return nil, err
+	}
+	client, err := s.GetClient(ctx, token.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	return &fosite.Request{
+		ID:                token.RequestID,
+		RequestedAt:       token.RequestedAt,
+		Client:            client,
+		RequestedScope:    token.RequestedScope,
+		GrantedScope:      token.GrantedScope,
+		Form:              token.Form,
+		Session:           session,
+		RequestedAudience: token.RequestedAudience,
+		GrantedAudience:   token.GrantedAudience,
+	}, nil
+}
+
+func (s *tokenStore) get(ctx context.Context, key string) (storedToken, error) {
+	var res storedToken
+	val, err := s.kv.Get(ctx, key)
+	if errors.Is(err, types.ErrNotFound) {
+		return res, fosite.ErrNotFound
+	}
+	if err != nil {
+		return res, err
+	}
+	err = json.Unmarshal(val, &res)
+	return res, err
+}
+
+func (s *tokenStore) put(ctx context.Context, key string, token storedToken) error {
+	val, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	return s.kv.Set(ctx, key, val)
+}
+
+func (s *tokenStore) delete(ctx context.Context, key string) error {
+	return s.kv.Delete(ctx, key)
+}
+
+// refreshTokenKeys returns keys of refresh tokens issued for request.
+func (s *tokenStore) refreshTokenKeys(ctx context.Context, requestID string) ([]string, error) {
+	entries, err := s.kv.List(ctx, requestKeyPrefix+requestID+"/")
+	if err != nil {
+		return nil, err
+	}
+	var res []string
+	for key := range entries {
+		if tokenKey, tokenType, ok := indexedTokenKey(key); ok && tokenType == fosite.RefreshToken {
+			res = append(res, tokenKey)
+		}
+	}
+	return res, nil
+}
+
+// revoke adds access token ID to revocation list until token expiration.
+func (s *tokenStore) revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	if tokenID == "" {
+		return nil
+	}
+	if err := s.kv.Set(ctx, revokedKeyPrefix+tokenID, []byte(strconv.FormatInt(expiresAt.Unix(), 10))); err != nil {
+		return err
+	}
+	s.revokedMu.Lock()
+	defer s.revokedMu.Unlock()
+	if s.revoked != nil {
+		s.revoked[tokenID] = expiresAt.Unix()
+	}
+	return nil
+}
+
+// isRevoked checks if access token ID is in revocation list. The list is reloaded if it is older than cacheTTL.
+func (s *tokenStore) isRevoked(ctx context.Context, tokenID string) (bool, error) {
+	s.revokedMu.Lock()
+	defer s.revokedMu.Unlock()
+	if s.revoked == nil || time.Since(s.revokedLoaded) >= s.cacheTTL {
+		entries, err := s.kv.List(ctx, revokedKeyPrefix)
+		if err != nil {
+			return false, err
+		}
+		revoked := make(map[string]int64, len(entries))
+		for key, val := range entries {
+			revoked[strings.TrimPrefix(key, revokedKeyPrefix)], _ = strconv.ParseInt(string(val), 10, 64)
+		}
+		s.revoked, s.revokedLoaded = revoked, time.Now()
+	}
+	_, ok := s.revoked[tokenID]
+	return ok, nil
+}
@@ -0,0 +1,217 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/ory/fosite"
+	"github.com/ory/fosite/handler/oauth2"
+	"github.com/ory/fosite/storage"
+	"github.com/ory/fosite/token/jwt"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestTokenStore(kv kvStore) *tokenStore {
+	mem := storage.NewMemoryStore()
+	mem.Clients = map[string]fosite.Client{"test": &fosite.DefaultClient{ID: "test"}}
+	newSession := func() fosite.Session {
+		return &oauth2.JWTSession{JWTClaims: &jwt.JWTClaims{}, JWTHeader: &jwt.Headers{}}
+	}
+	return newTokenStore(mem, kv, newSession, Config{
+		AccessTokenLifespan:  time.Minute,
+		RefreshTokenLifespan: time.Hour,
+		TokenCacheTTL:        time.Minute,
+	})
+}
+
+func Test_TokenStore(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	kv := newMemKV()
+	replica1, replica2 := newTestTokenStore(kv), newTestTokenStore(kv)
+
+	req := fosite.NewRequest()
+	req.ID = "req-id"
+	req.Client = &fosite.DefaultClient{ID: "test"}
+	req.GrantedScope = fosite.Arguments{"openid"}
+	req.Form.Set("username", "admin")
+	req.Form.Set("password", "secret")
+	req.Session = &oauth2.JWTSession{JWTClaims: &jwt.JWTClaims{Subject: "admin", JTI: "access-id"}, JWTHeader: &jwt.Headers{}, Subject: "admin",
+		ExpiresAt: map[fosite.TokenType]time.Time{fosite.AccessToken: time.Now().Add(time.Minute)}}
+
+	r.NoError(replica1.CreateAccessTokenSession(ctx, "access-token", req))
+	r.NoError(replica1.CreateRefreshTokenSession(ctx, "refresh-sig", req))
+	entries, err := kv.List(ctx, tokenKeyPrefix+string(fosite.AccessToken))
+	r.NoError(err)
+	r.Empty(entries, "access tokens are not stored")
+
+	// tokens are visible to other replica
+	res, err := replica2.GetRefreshTokenSession(ctx, "refresh-sig", nil)
+	r.NoError(err)
+	r.EqualValues("admin", res.GetSession().GetSubject())
+	r.EqualValues(fosite.Arguments{"openid"}, res.GetGrantedScopes())
+	r.Empty(res.GetRequestForm().Get("password"), "secrets are not stored")
+
+	// revocation on one replica is visible to others
+	revoked, err := replica1.isRevoked(ctx, "access-id")
+	r.NoError(err)
+	r.False(revoked)
+	r.NoError(replica2.RevokeRefreshToken(ctx, "req-id"))
+	r.NoError(replica2.RevokeAccessToken(ctx, "req-id"))
+	_, err = replica1.GetRefreshTokenSession(ctx, "refresh-sig", nil)
+	r.ErrorIs(err, fosite.ErrInactiveToken)
+	revoked, err = replica2.isRevoked(ctx, "access-id")
+	r.NoError(err)
+	r.True(revoked, "access token issued with refresh token is revoked")
+	// replica1 revocation list is cached
+	revoked, err = replica1.isRevoked(ctx, "access-id")
+	r.NoError(err)
+	r.False(revoked)
+	replica1.cacheTTL = 0
+	revoked, err = replica1.isRevoked(ctx, "access-id")
+	r.NoError(err)
+	r.True(revoked)
+
+	// access token without refresh token is revoked by its ID
+	replica2.cacheTTL = 0
+	r.NoError(replica1.RevokeAccessToken(ctx, "other-access-id"))
+	revoked, err = replica2.isRevoked(ctx, "other-access-id")
+	r.NoError(err)
+	r.True(revoked)
+
+	// expired tokens and revocations are deleted
+	expired := fosite.NewRequest()
+	expired.ID = "expired-id"
+	expired.Client = &fosite.DefaultClient{ID: "test"}
+	expired.Session = &oauth2.JWTSession{JWTClaims: &jwt.JWTClaims{}, JWTHeader: &jwt.Headers{},
+		ExpiresAt: map[fosite.TokenType]time.Time{fosite.RefreshToken: time.Now().Add(-time.Minute)}}
+	r.NoError(replica1.CreateRefreshTokenSession(ctx, "expired-sig", expired))
+	r.NoError(replica1.revoke(ctx, "expired-access-id", time.Now().Add(-time.Minute)))
+	r.NoError(replica1.deleteExpired(ctx))
+	_, err = replica1.GetRefreshTokenSession(ctx, "expired-sig", nil)
+	r.ErrorIs(err, fosite.ErrNotFound)
+	_, err = replica1.GetRefreshTokenSession(ctx, "refresh-sig", nil)
+	r.ErrorIs(err, fosite.ErrInactiveToken)
+	entries, err = kv.List(ctx, revokedKeyPrefix)
+	r.NoError(err)
+	r.Len(entries, 2)
+	r.NotContains(entries, revokedKeyPrefix+"expired-access-id")
+}
+
+func Test_AccessToken_stateless(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	userSvc := newTestUserSvc(t)
+	srv := newTestServer(t, userSvc)
+	authFn := AuthFunc(userSvc, srv.Provider(), srv.GetPublicKey, srv.ClientRoles, nil, nil)
+	r.NoError(userSvc.CreateUser(ctx, user.User{Username: "alice", Password: "secret", Roles: []string{"read-only"}, Enabled: true}))
+	token := func(form url.Values) (string, string) {
+		req := httptest.NewRequest(http.MethodPost, "/api/oauth/token", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth("ceph-api", "")
+		rec := httptest.NewRecorder()
+		srv.TokenEndpoint(rec, req)
+		r.EqualValues(http.StatusOK, rec.Code, rec.Body.String())
+		var res struct {
+			AccessToken  string `json:"access_token"`
+			RefreshToken string `json:"refresh_token"`
+		}
+		r.NoError(json.Unmarshal(rec.Body.Bytes(), &res))
+		return res.AccessToken, res.RefreshToken
+	}
+
+	access1, refresh1 := token(url.Values{"grant_type": {GrantPassword}, "username": {"alice"}, "password": {"secret"}, "scope": {"offline"}})
+	_, err := authFn(grpcCtx(access1))
+	r.NoError(err)
+	entries, err := srv.tokens.kv.List(ctx, tokenKeyPrefix+string(fosite.AccessToken))
+	r.NoError(err)
+	r.Empty(entries, "access tokens are not stored")
+
+	// access token issued with refreshed token is revoked
+	access2, refresh2 := token(url.Values{"grant_type": {"refresh_token"}, "refresh_token": {refresh1}})
+	_, err = authFn(grpcCtx(access2))
+	r.NoError(err)
+	_, err = authFn(grpcCtx(access1))
+	r.EqualValues(codes.Unauthenticated, status.Code(err))
+
+	revoke := func(token string) {
+		form := url.Values{"token": {token}}
+		req := httptest.NewRequest(http.MethodPost, "/api/oauth/revoke", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth("ceph-api", "")
+		rec := httptest.NewRecorder()
+		srv.RevokeEndpoint(rec, req)
+		r.EqualValues(http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	// revocation of refresh token revokes access token
+	revoke(refresh2)
+	_, err = authFn(grpcCtx(access2))
+	r.EqualValues(codes.Unauthenticated, status.Code(err))
+
+	// access token can be revoked directly
+	access3, _ := token(url.Values{"grant_type": {GrantPassword}, "username": {"alice"}, "password": {"secret"}})
+	_, err = authFn(grpcCtx(access3))
+	r.NoError(err)
+	revoke(access3)
+	_, err = authFn(grpcCtx(access3))
+	r.EqualValues(codes.Unauthenticated, status.Code(err))
+
+	// other JWTs signed with the same key are not access tokens
+	parsed, err := srv.tokens.signer.Decode(ctx, access2)
+	r.NoError(err)
+	claims := parsed.Claims
+	claims["jti"] = "other"
+	other, _, err := srv.tokens.signer.Generate(ctx, claims, &jwt.Headers{})
+	r.NoError(err)
+	_, err = authFn(grpcCtx(other))
+	r.EqualValues(codes.Unauthenticated, status.Code(err))
+}
+
+func Test_TokenStore_concurrentIndex(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	kv := &cephKV{radosSvc: rados.NewWithConn(&configKeyConn{data: map[string][]byte{}})}
+	replica1, replica2 := newTestTokenStore(kv), newTestTokenStore(kv)
+	newReq := func(id string) *fosite.Request {
+		req := fosite.NewRequest()
+		req.ID = id
+		req.Client = &fosite.DefaultClient{ID: "test"}
+		req.Session = &oauth2.JWTSession{JWTClaims: &jwt.JWTClaims{Subject: "admin"}, JWTHeader: &jwt.Headers{}, Subject: "admin"}
+		return req
+	}
+
+	// tokens of the same request created by different replicas are all indexed
+	var wg sync.WaitGroup
+	for i, s := range []*tokenStore{replica1, replica2, replica1, replica2} {
+		wg.Add(1)
+		go func(i int, s *tokenStore) {
+			defer wg.Done()
+			require.NoError(t, s.CreateRefreshTokenSession(ctx, fmt.Sprintf("refresh-%d", i), newReq("req-id")))
+		}(i, s)
+	}
+	wg.Wait()
+	r.NoError(replica1.RevokeRefreshToken(ctx, "req-id"))
+	for i := 0; i < 4; i++ {
+		_, err := replica2.GetRefreshTokenSession(ctx, fmt.Sprintf("refresh-%d", i), nil)
+		r.ErrorIs(err, fosite.ErrInactiveToken)
+	}
+
+	// other requests are not affected
+	r.NoError(replica1.CreateRefreshTokenSession(ctx, "other", newReq("req-id2")))
+	r.NoError(replica1.RevokeRefreshToken(ctx, "req-id"))
+	_, err := replica2.GetRefreshTokenSession(ctx, "other", nil)
+	r.NoError(err)
+}
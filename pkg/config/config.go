@@ -6,13 +6,16 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/clyso/ceph-api/pkg/api"
+	"github.com/clyso/ceph-api/pkg/audit"
 	"github.com/clyso/ceph-api/pkg/auth"
 	"github.com/clyso/ceph-api/pkg/log"
 	"github.com/clyso/ceph-api/pkg/metrics"
 	"github.com/clyso/ceph-api/pkg/rados"
 	"github.com/clyso/ceph-api/pkg/trace"
+	"github.com/clyso/ceph-api/pkg/user"
 	"github.com/spf13/viper"
 )
 
@@ -35,13 +38,21 @@ type Config struct {
 
 	Rados rados.Config `yaml:"rados"`
 
+	Clusters map[string]rados.Config `yaml:"clusters"`
+
 	Auth auth.Config `yaml:"auth"`
 
+	Audit audit.Config `yaml:"audit"`
+
 	App struct {
 		CreateAdmin   bool   `yaml:"createAdmin"`
 		AdminUsername string `yaml:"adminUsername"`
 		AdminPassword string `yaml:"adminPassword"`
-		BcryptPwdCost int    `yaml:"bcryptPwdCos"`
+		BcryptPwdCost int    `yaml:"bcryptPwdCost"`
+		// AccessDBReloadInterval - how often access DB changed by other API replicas or dashboard is reloaded.
+		AccessDBReloadInterval time.Duration `yaml:"accessDBReloadInterval"`
+		// PwdPolicy - checks of passwords set by admins and users.
+		PwdPolicy user.PasswordPolicy `yaml:"pwdPolicy"`
 	} `yaml:"app"`
 }
 
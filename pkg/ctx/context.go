@@ -2,6 +2,7 @@ package ctx
 
 import (
 	"context"
+	"time"
 )
 
 type traceKey struct{}
@@ -37,3 +38,63 @@ func GetPermissions(ctx context.Context) map[string][]string {
 	res, _ := ctx.Value(permKey{}).(map[string][]string)
 	return res
 }
+
+type noCacheKey struct{}
+
+// SetNoCache marks request to bypass RADOS read cache.
+func SetNoCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+func GetNoCache(ctx context.Context) bool {
+	res, _ := ctx.Value(noCacheKey{}).(bool)
+	return res
+}
+
+type clusterKey struct{}
+
+// SetCluster sets name of Ceph cluster selected for request.
+func SetCluster(ctx context.Context, in string) context.Context {
+	return context.WithValue(ctx, clusterKey{}, in)
+}
+
+func GetCluster(ctx context.Context) string {
+	res, _ := ctx.Value(clusterKey{}).(string)
+	return res
+}
+
+type sourceIPKey struct{}
+
+// SetSourceIP sets IP address of API client.
+func SetSourceIP(ctx context.Context, in string) context.Context {
+	return context.WithValue(ctx, sourceIPKey{}, in)
+}
+
+func GetSourceIP(ctx context.Context) string {
+	res, _ := ctx.Value(sourceIPKey{}).(string)
+	return res
+}
+
+type rolesKey struct{}
+
+// SetRoles sets roles granted to authenticated user or OAuth client.
+func SetRoles(ctx context.Context, in []string) context.Context {
+	return context.WithValue(ctx, rolesKey{}, in)
+}
+
+func GetRoles(ctx context.Context) []string {
+	res, _ := ctx.Value(rolesKey{}).([]string)
+	return res
+}
+
+type expiresAtKey struct{}
+
+// SetExpiresAt sets expiration time of access token used in request.
+func SetExpiresAt(ctx context.Context, in time.Time) context.Context {
+	return context.WithValue(ctx, expiresAtKey{}, in)
+}
+
+func GetExpiresAt(ctx context.Context) time.Time {
+	res, _ := ctx.Value(expiresAtKey{}).(time.Time)
+	return res
+}
@@ -13,7 +13,7 @@ func HttpMiddleware(cfg Config, next http.Handler) http.Handler {
 		if zerolog.GlobalLevel() < zerolog.InfoLevel {
 			builder = builder.Str(httpMethod, r.Method).
 				Str(httpPath, r.URL.Path).
-				Str(httpQuery, r.URL.RawQuery)
+				Str(httpQuery, RedactQuery(r.URL.RawQuery))
 		}
 		newLogger := builder.Logger()
 
@@ -19,11 +19,17 @@ const (
 type Config struct {
 	Json  bool   `yaml:"json"`
 	Level string `yaml:"level"`
+	// UnsafeNoRedact - log keyrings, passwords and tokens as is. Use only for debugging.
+	UnsafeNoRedact bool `yaml:"unsafeNoRedact"`
 }
 
 func GetLogger(cfg Config) zerolog.Logger {
 	logger := CreateLogger(cfg)
 	zerolog.DefaultContextLogger = &logger
+	unsafeNoRedact.Store(cfg.UnsafeNoRedact)
+	if cfg.UnsafeNoRedact {
+		logger.Warn().Msg("secrets redaction in logs is disabled")
+	}
 	return logger
 }
 
@@ -0,0 +1,185 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync/atomic"
+)
+
+// Redacted - placeholder of masked secret value.
+const Redacted = "[REDACTED]"
+
+var (
+	// unsafeNoRedact - disables redaction of logged secrets, set with Config.UnsafeNoRedact.
+	unsafeNoRedact atomic.Bool
+
+	// sensitiveFields - normalized names of JSON fields and URL query params with secret values in any context.
+	// Generic names like "key" or "data" are listed in sensitiveCmdFields for commands where they hold secrets.
+	sensitiveFields = map[string]struct{}{
+		"keyring":       {},
+		"password":      {},
+		"oldpassword":   {},
+		"newpassword":   {},
+		"passwordhash":  {},
+		"secret":        {},
+		"secrethash":    {},
+		"clientsecret":  {},
+		"accesstoken":   {},
+		"refreshtoken":  {},
+		"idtoken":       {},
+		"token":         {},
+		"authorization": {},
+		"otp":           {},
+		"recoverycodes": {},
+		// keyring
+		"importdata": {},
+	}
+	// sensitiveCmds - mon/mgr command prefixes with secret input buffer or result and their secret arguments.
+	sensitiveCmds = map[string][]string{
+		"auth get-key":           nil,
+		"auth get-or-create-key": nil,
+		"auth print-key":         nil,
+		"auth print_key":         nil,
+		"config-key get":         nil,
+		"config-key dump":        nil,
+		"config-key set":         {"val"},
+		"config-key put":         {"val"},
+	}
+	// sensitiveCmdFields - mon/mgr command prefixes and normalized names of fields which hold secrets in their
+	// JSON result or input buffer.
+	sensitiveCmdFields = map[string][]string{
+		"auth add":           {"key"},
+		"auth export":        {"key"},
+		"auth get":           {"key"},
+		"auth get-or-create": {"key"},
+		"auth import":        {"key"},
+		"auth list":          {"key"},
+		"auth ls":            {"key"},
+	}
+	// keyringKeyRe - key line of keyring in plain text format, e.g. "key = AQD...".
+	keyringKeyRe = regexp.MustCompile(`(?m)^(\s*key\s*=\s*)\S+`)
+)
+
+// IsSensitiveField reports whether JSON field or query param with given name holds secret value.
+// Name is matched case-insensitively in both snake_case and camelCase.
+func IsSensitiveField(name string) bool {
+	_, ok := sensitiveFields[normalizeField(name)]
+	return ok
+}
+
+func normalizeField(name string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+}
+
+// RedactData returns JSON with values of sensitive fields masked. Non-JSON data is treated as plain text keyring.
+func RedactData(data []byte) string {
+	return redactData(data, nil)
+}
+
+// redactData masks values of sensitive fields and given context specific fields.
+func redactData(data []byte, fields []string) string {
+	if unsafeNoRedact.Load() || len(data) == 0 {
+		return string(data)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var val any
+	if err := dec.Decode(&val); err != nil || dec.More() {
+		return keyringKeyRe.ReplaceAllString(string(data), "${1}"+Redacted)
+	}
+	res, err := json.Marshal(redactValue(val, fields))
+	if err != nil {
+		return Redacted
+	}
+	return string(res)
+}
+
+func redactValue(val any, fields []string) any {
+	switch v := val.(type) {
+	case map[string]any:
+		for k, fv := range v {
+			if IsSensitiveField(k) || slices.Contains(fields, normalizeField(k)) {
+				v[k] = Redacted
+				continue
+			}
+			v[k] = redactValue(fv, fields)
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactValue(v[i], fields)
+		}
+	case string:
+		return keyringKeyRe.ReplaceAllString(v, "${1}"+Redacted)
+	}
+	return val
+}
+
+// RedactCmd returns JSON mon/mgr command with secret arguments masked.
+func RedactCmd(cmd string) string {
+	if unsafeNoRedact.Load() {
+		return cmd
+	}
+	var args map[string]any
+	if err := json.Unmarshal([]byte(cmd), &args); err != nil {
+		return cmd
+	}
+	prefix, _ := args["prefix"].(string)
+	secretArgs, ok := sensitiveCmds[prefix]
+	if !ok || len(secretArgs) == 0 {
+		return cmd
+	}
+	for _, arg := range secretArgs {
+		if _, ok := args[arg]; ok {
+			args[arg] = Redacted
+		}
+	}
+	res, err := json.Marshal(args)
+	if err != nil {
+		return Redacted
+	}
+	return string(res)
+}
+
+// RedactCmdData returns input buffer or result of mon/mgr command with secrets masked.
+// Data of known-sensitive commands is masked entirely.
+func RedactCmdData(cmd string, data []byte) string {
+	if unsafeNoRedact.Load() || len(data) == 0 {
+		return string(data)
+	}
+	var args struct {
+		Prefix string `json:"prefix"`
+	}
+	if err := json.Unmarshal([]byte(cmd), &args); err != nil {
+		return Redacted
+	}
+	if _, ok := sensitiveCmds[args.Prefix]; ok {
+		return Redacted
+	}
+	return redactData(data, sensitiveCmdFields[args.Prefix])
+}
+
+// RedactQuery returns URL query with values of sensitive params masked.
+func RedactQuery(rawQuery string) string {
+	if unsafeNoRedact.Load() || rawQuery == "" {
+		return rawQuery
+	}
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return Redacted
+	}
+	found := false
+	for k := range query {
+		if IsSensitiveField(k) {
+			query[k] = []string{Redacted}
+			found = true
+		}
+	}
+	if !found {
+		return rawQuery
+	}
+	return query.Encode()
+}
@@ -0,0 +1,70 @@
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RedactData(t *testing.T) {
+	r := require.New(t)
+	res := RedactCmdData(`{"prefix": "auth ls", "format": "json"}`, []byte(`[{"entity":"client.a","key":"AQD123==","caps":{"mon":"allow r"}}]`))
+	r.NotContains(res, "AQD123")
+	r.Contains(res, `"entity":"client.a"`)
+	r.Contains(res, `"mon":"allow r"`)
+	// generic names are masked only where they hold secrets
+	r.Equal(`{"data":"d","key":"k","signature":"s"}`, RedactData([]byte(`{"data":"d","key":"k","signature":"s"}`)))
+	r.Equal(`{"key":"osd_pool_default_size"}`, RedactCmdData(`{"prefix": "config dump", "format": "json"}`, []byte(`{"key":"osd_pool_default_size"}`)))
+
+	res = RedactData([]byte(`{"users":{"admin":{"password":"$2b$12$hash","recoveryCodes":["a","b"],"roles":["administrator"]}},"access_token":"tok","size":12345678901234567890}`))
+	r.NotContains(res, "$2b$12$hash")
+	r.NotContains(res, `"tok"`)
+	r.NotContains(res, `["a","b"]`)
+	r.Contains(res, `"roles":["administrator"]`)
+	r.Contains(res, "12345678901234567890", "numbers are not changed")
+
+	res = RedactData([]byte("[client.a]\n\tkey = AQD123==\n\tcaps mon = \"allow r\"\n"))
+	r.NotContains(res, "AQD123")
+	r.Contains(res, "[client.a]")
+	r.Contains(res, `caps mon = "allow r"`)
+
+	r.Equal("", RedactData(nil))
+	r.Equal("HEALTH_OK", RedactData([]byte("HEALTH_OK")))
+}
+
+func Test_RedactCmd(t *testing.T) {
+	r := require.New(t)
+	cmd := `{"prefix": "osd pool set", "pool": "a", "var": "size", "val": "3"}`
+	r.Equal(cmd, RedactCmd(cmd))
+	res := RedactCmd(`{"prefix": "config-key set", "key": "mgr/a", "val": "secret"}`)
+	r.NotContains(res, "secret")
+	r.Contains(res, `"key":"mgr/a"`)
+	r.Equal("not json", RedactCmd("not json"))
+
+	r.Equal(Redacted, RedactCmdData(`{"prefix": "auth print-key", "entity": "client.a"}`, []byte("AQD123==")))
+	r.Equal(Redacted, RedactCmdData(`{"prefix": "config-key set", "key": "mgr/a"}`, []byte(`{"users":{}}`)))
+	r.NotContains(RedactCmdData(`{"prefix": "auth export", "format": "json"}`, []byte(`[{"entity":"client.a","key":"AQD123=="}]`)), "AQD123")
+	r.Equal(`{"health":"ok"}`, RedactCmdData(`{"prefix": "health", "format": "json"}`, []byte(`{"health":"ok"}`)))
+	r.Equal("", RedactCmdData(`{"prefix": "config-key get", "key": "mgr/a"}`, nil))
+}
+
+func Test_RedactQuery(t *testing.T) {
+	r := require.New(t)
+	r.Equal("days=1&limit=10", RedactQuery("days=1&limit=10"))
+	res := RedactQuery("access_token=s3cr3t&days=1")
+	r.NotContains(res, "s3cr3t")
+	r.Contains(res, "days=1")
+	r.Equal("", RedactQuery(""))
+}
+
+func Test_UnsafeNoRedact(t *testing.T) {
+	r := require.New(t)
+	GetLogger(Config{Level: "info", UnsafeNoRedact: true})
+	defer unsafeNoRedact.Store(false)
+	r.Equal(`{"key":"AQD123=="}`, RedactCmdData(`{"prefix": "auth export"}`, []byte(`{"key":"AQD123=="}`)))
+	r.Equal("AQD123==", RedactCmdData(`{"prefix": "auth print-key"}`, []byte("AQD123==")))
+	r.Equal("token=tok", RedactQuery("token=tok"))
+
+	GetLogger(Config{Level: "info"})
+	r.Equal(`{"key":"[REDACTED]"}`, RedactCmdData(`{"prefix": "auth export"}`, []byte(`{"key":"AQD123=="}`)))
+}
@@ -0,0 +1,175 @@
+package rados
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
+)
+
+var (
+	cacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "ceph_api",
+		Subsystem: "rados",
+		Name:      "cache_hits_total",
+		Help:      "Number of read commands served from cache.",
+	})
+	coalescedCmds = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "ceph_api",
+		Subsystem: "rados",
+		Name:      "coalesced_commands_total",
+		Help:      "Number of read commands which shared result with identical concurrent command.",
+	})
+)
+
+type CacheConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// TTL - cache time to live by mon command prefix. Commands without TTL are not cached but still coalesced.
+	TTL map[string]time.Duration `yaml:"ttl"`
+}
+
+// sharedFetchTimeout - min timeout of command shared by coalesced callers.
+// Shared command is not cancelled with the first caller, but should not wait for execution slot forever.
+const sharedFetchTimeout = time.Minute
+
+// isolatedScopes - first words of write command prefixes which change only state read by commands with the same first word.
+// Other write commands drop the whole cache.
+var isolatedScopes = map[string]bool{
+	"auth":       true,
+	"config":     true,
+	"config-key": true,
+}
+
+// cache stores results of read commands and coalesces identical concurrent read commands.
+// Cache epoch is incremented on every write command: affected cached results are dropped and results of
+// affected commands started before the write are not cached. Writes of isolated scopes increment only scope epoch.
+type cache struct {
+	ttl   map[string]time.Duration
+	group singleflight.Group
+
+	mu          sync.Mutex
+	epoch       uint64
+	scopeEpochs map[string]uint64
+	entries     map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	res     []byte
+	scope   string
+	expires time.Time
+}
+
+func newCache(conf CacheConfig) *cache {
+	if !conf.Enabled {
+		return nil
+	}
+	return &cache{ttl: conf.TTL, entries: map[string]cacheEntry{}, scopeEpochs: map[string]uint64{}}
+}
+
+// get returns cached command result or executes fetch. Identical concurrent commands are executed only once.
+func (c *cache) get(ctx context.Context, kind, cmd string, fetch func(ctx context.Context) ([]byte, string, error)) ([]byte, string, error) {
+	key := kind + "/" + string(canonicalCmd([]byte(cmd)))
+	prefix := cmdPrefix(cmd)
+	ttl, scope := c.ttl[prefix], cmdScope(prefix)
+	noCache := xctx.GetNoCache(ctx)
+
+	c.mu.Lock()
+	epoch, scopeEpoch := c.epoch, c.scopeEpochs[scope]
+	if e, ok := c.entries[key]; ok && !noCache && time.Now().Before(e.expires) {
+		c.mu.Unlock()
+		cacheHits.Inc()
+		return e.res, "", nil
+	}
+	c.mu.Unlock()
+
+	store := func(res []byte) {
+		if ttl <= 0 {
+			return
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		// do not cache results of commands started before write command
+		if c.epoch == epoch && c.scopeEpochs[scope] == scopeEpoch {
+			c.entries[key] = cacheEntry{res: res, scope: scope, expires: time.Now().Add(ttl)}
+		}
+	}
+	if noCache {
+		res, status, err := fetch(ctx)
+		if err == nil {
+			store(res)
+		}
+		return res, status, err
+	}
+
+	type result struct {
+		res    []byte
+		status string
+	}
+	ch := c.group.DoChan(strconv.FormatUint(epoch, 10)+"/"+strconv.FormatUint(scopeEpoch, 10)+"/"+key, func() (any, error) {
+		// shared command should not be abandoned when the first caller is gone
+		timeout := sharedFetchTimeout
+		if deadline, ok := ctx.Deadline(); ok {
+			timeout = max(timeout, time.Until(deadline))
+		}
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		defer cancel()
+		res, status, err := fetch(fetchCtx)
+		if err == nil {
+			store(res)
+		}
+		return result{res: res, status: status}, err
+	})
+	select {
+	case <-ctx.Done():
+		return nil, "", fmt.Errorf("%w: rados command abandoned", ctx.Err())
+	case r := <-ch:
+		if r.Shared {
+			coalescedCmds.Inc()
+		}
+		if r.Err != nil {
+			return nil, "", r.Err
+		}
+		res := r.Val.(result)
+		return res.res, res.status, nil
+	}
+}
+
+// invalidate drops cached results affected by write command.
+func (c *cache) invalidate(cmd string) {
+	scope := cmdScope(cmdPrefix(cmd))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !isolatedScopes[scope] {
+		c.epoch++
+		c.entries = map[string]cacheEntry{}
+		return
+	}
+	c.scopeEpochs[scope]++
+	for k, e := range c.entries {
+		if e.scope == scope {
+			delete(c.entries, k)
+		}
+	}
+}
+
+func cmdPrefix(cmd string) string {
+	var parsed struct {
+		Prefix string `json:"prefix"`
+	}
+	_ = json.Unmarshal([]byte(cmd), &parsed)
+	return parsed.Prefix
+}
+
+// cmdScope returns the first word of command prefix, e.g. "osd" for "osd pool create".
+func cmdScope(prefix string) string {
+	scope, _, _ := strings.Cut(prefix, " ")
+	return scope
+}
@@ -0,0 +1,118 @@
+package rados
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/stretchr/testify/require"
+)
+
+type countingConn struct {
+	blockingConn
+	calls atomic.Int32
+}
+
+func (c *countingConn) MonCommand(args []byte) ([]byte, string, error) {
+	c.calls.Add(1)
+	return c.blockingConn.MonCommand(args)
+}
+
+func Test_Cache(t *testing.T) {
+	r := require.New(t)
+	const (
+		osdDump = `{"prefix": "osd dump", "format": "json"}`
+		monDump = `{"prefix": "mon dump", "format": "json"}`
+		setCmd  = `{"prefix": "config-key set", "key": "k"}`
+	)
+	ctx := context.Background()
+	conn := &countingConn{blockingConn: blockingConn{
+		release:  make(chan struct{}),
+		fakeConn: fakeConn{res: map[string][]byte{osdDump: []byte(`{"epoch": 1}`), monDump: []byte(`{}`)}},
+	}}
+	svc := NewWithConn(conn)
+	svc.cache = newCache(CacheConfig{Enabled: true, TTL: map[string]time.Duration{"osd dump": time.Minute}})
+
+	// identical concurrent commands are coalesced
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := svc.ExecMon(ctx, osdDump)
+			r.NoError(err)
+			r.JSONEq(`{"epoch": 1}`, string(res))
+		}()
+	}
+	r.Eventually(func() bool { return conn.calls.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	close(conn.release)
+	wg.Wait()
+	r.EqualValues(1, conn.calls.Load())
+
+	// served from cache, field order does not matter
+	_, err := svc.ExecMon(ctx, `{"format": "json", "prefix": "osd dump"}`)
+	r.NoError(err)
+	r.EqualValues(1, conn.calls.Load())
+
+	// commands without ttl are not cached
+	_, err = svc.ExecMon(ctx, monDump)
+	r.NoError(err)
+	_, err = svc.ExecMon(ctx, monDump)
+	r.NoError(err)
+	r.EqualValues(3, conn.calls.Load())
+
+	// cache bypass
+	_, err = svc.ExecMon(xctx.SetNoCache(ctx), osdDump)
+	r.NoError(err)
+	r.EqualValues(4, conn.calls.Load())
+
+	// config-key write drops only config-key results
+	_, err = svc.ExecMonWithInputBuff(ctx, setCmd, []byte("val"))
+	r.NoError(err)
+	_, err = svc.ExecMon(ctx, osdDump)
+	r.NoError(err)
+	r.EqualValues(4, conn.calls.Load())
+
+	// other write command drops cache
+	_, _ = svc.ExecMon(ctx, `{"prefix": "osd pool create", "pool": "p"}`)
+	r.EqualValues(5, conn.calls.Load())
+	_, err = svc.ExecMon(ctx, osdDump)
+	r.NoError(err)
+	r.EqualValues(6, conn.calls.Load())
+}
+
+func Test_Cache_invalidateScope(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	c := newCache(CacheConfig{Enabled: true, TTL: map[string]time.Duration{"osd dump": time.Minute, "config-key get": time.Minute}})
+	calls := 0
+	fetch := func(ctx context.Context) ([]byte, string, error) {
+		_, ok := ctx.Deadline()
+		r.True(ok, "shared command has deadline")
+		calls++
+		return []byte("res"), "", nil
+	}
+	get := func(cmd string) {
+		_, _, err := c.get(ctx, cmdKindMon, cmd, fetch)
+		r.NoError(err)
+	}
+	osdDump, keyGet := `{"prefix": "osd dump"}`, `{"prefix": "config-key get", "key": "k"}`
+	get(osdDump)
+	get(keyGet)
+	r.EqualValues(2, calls)
+
+	c.invalidate(`{"prefix": "config-key set", "key": "k"}`)
+	get(osdDump)
+	r.EqualValues(2, calls, "osd dump is kept")
+	get(keyGet)
+	r.EqualValues(3, calls)
+
+	c.invalidate(`{"prefix": "osd pool set", "pool": "p"}`)
+	get(osdDump)
+	get(keyGet)
+	r.EqualValues(5, calls)
+}
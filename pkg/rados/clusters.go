@@ -0,0 +1,93 @@
+package rados
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/types"
+)
+
+// DefaultCluster - name of the cluster configured in rados config section.
+// It serves requests without cluster selector and stores API users and roles.
+const DefaultCluster = "default"
+
+// Clusters routes commands to the Ceph cluster selected in request context.
+type Clusters struct {
+	names []string
+	svcs  map[string]*Svc
+}
+
+func NewClusters(defaultSvc *Svc, named map[string]*Svc) *Clusters {
+	res := &Clusters{
+		names: []string{DefaultCluster},
+		svcs:  map[string]*Svc{DefaultCluster: defaultSvc},
+	}
+	for name, svc := range named {
+		res.names = append(res.names, name)
+		res.svcs[name] = svc
+	}
+	sort.Strings(res.names[1:])
+	return res
+}
+
+// ValidateClusterName checks that name can be used in cluster selector and RBAC scope.
+func ValidateClusterName(name string) error {
+	if name == "" || name == DefaultCluster || strings.ContainsAny(name, "/@: ") {
+		return fmt.Errorf("%w: invalid cluster name %q: name should not be empty, %q, or contain '/', '@', ':' and spaces", types.ErrInvalidConfig, name, DefaultCluster)
+	}
+	return nil
+}
+
+// Names returns cluster names. Default cluster goes first.
+func (c *Clusters) Names() []string {
+	return c.names
+}
+
+func (c *Clusters) Default() *Svc {
+	return c.svcs[DefaultCluster]
+}
+
+// Get returns cluster by name. Empty name stands for default cluster.
+func (c *Clusters) Get(name string) (*Svc, error) {
+	if name == "" {
+		name = DefaultCluster
+	}
+	svc, ok := c.svcs[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown cluster %q", types.ErrNotFound, name)
+	}
+	return svc, nil
+}
+
+func (c *Clusters) ExecMon(ctx context.Context, cmd string) ([]byte, error) {
+	svc, err := c.Get(xctx.GetCluster(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return svc.ExecMon(ctx, cmd)
+}
+
+func (c *Clusters) ExecMonWithInputBuff(ctx context.Context, cmd string, inputBuffer []byte) ([]byte, error) {
+	svc, err := c.Get(xctx.GetCluster(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return svc.ExecMonWithInputBuff(ctx, cmd, inputBuffer)
+}
+
+func (c *Clusters) ExecMgr(ctx context.Context, cmd string) ([]byte, error) {
+	svc, err := c.Get(xctx.GetCluster(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return svc.ExecMgr(ctx, cmd)
+}
+
+func (c *Clusters) Close() {
+	for _, svc := range c.svcs {
+		svc.Close()
+	}
+}
@@ -0,0 +1,229 @@
+package rados
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/clyso/ceph-api/pkg/types"
+)
+
+// commandsFS - command signatures in "get_command_descriptions" format.
+// get_command_descriptions_dump.json is dumped from mgr. mon_command_descriptions.json is written by hand from
+// Ceph MonCommands.h and lists only mon commands used by API.
+//
+//go:embed commands/*.json
+var commandsFS embed.FS
+
+// cmdSigs - command signatures by prefix.
+var cmdSigs map[string][]cmdSig = func() map[string][]cmdSig {
+	res, err := parseCmdSigs(commandsFS)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}()
+
+var pgidRe = regexp.MustCompile(`^[0-9]+\.[0-9a-fA-F]+$`)
+
+// MonCmd - mon or mgr command. Use Build to validate arguments against command signature and get JSON command.
+type MonCmd struct {
+	Prefix string
+	// Args - command arguments, e.g. {"entity": "client.a", "caps": []string{"mon", "allow r"}}.
+	Args map[string]any
+}
+
+// Build validates command and returns it as JSON.
+func (c MonCmd) Build() (string, error) {
+	if err := c.Validate(); err != nil {
+		return "", err
+	}
+	cmd := make(map[string]any, len(c.Args)+1)
+	for k, v := range c.Args {
+		cmd[k] = v
+	}
+	cmd["prefix"] = c.Prefix
+	res, err := json.Marshal(cmd)
+	if err != nil {
+		return "", fmt.Errorf("%w: unable to marshal command %q: %w", types.ErrInvalidArg, c.Prefix, err)
+	}
+	return string(res), nil
+}
+
+// Validate checks command arguments types, allowed choices and required arguments.
+func (c MonCmd) Validate() error {
+	sigs, ok := cmdSigs[c.Prefix]
+	if !ok {
+		return fmt.Errorf("%w: unknown command %q", types.ErrInternal, c.Prefix)
+	}
+	var err error
+	for _, sig := range sigs {
+		if err = sig.validate(c.Args); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: command %q: %w", types.ErrInvalidArg, c.Prefix, err)
+}
+
+type cmdArg struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// Req - argument is required if not set
+	Req *bool `json:"req"`
+	// N - "N" for list argument
+	N         string `json:"n"`
+	Strings   string `json:"strings"`
+	GoodChars string `json:"goodchars"`
+
+	goodChars *regexp.Regexp
+	choices   []string
+}
+
+type cmdSig struct {
+	prefix string
+	args   []cmdArg
+}
+
+func parseCmdSigs(fsys fs.FS) (map[string][]cmdSig, error) {
+	files, err := fs.Glob(fsys, "commands/*.json")
+	if err != nil {
+		return nil, err
+	}
+	res := map[string][]cmdSig{}
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+		var cmds map[string]struct {
+			Sig []json.RawMessage `json:"sig"`
+		}
+		if err = json.Unmarshal(data, &cmds); err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", file, err)
+		}
+		for id, cmd := range cmds {
+			sig, err := parseCmdSig(cmd.Sig)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse %s %s: %w", file, id, err)
+			}
+			res[sig.prefix] = append(res[sig.prefix], sig)
+		}
+	}
+	return res, nil
+}
+
+func parseCmdSig(raw []json.RawMessage) (cmdSig, error) {
+	var res cmdSig
+	var prefix []string
+	for _, r := range raw {
+		var word string
+		if json.Unmarshal(r, &word) == nil {
+			prefix = append(prefix, word)
+			continue
+		}
+		var arg cmdArg
+		if err := json.Unmarshal(r, &arg); err != nil {
+			return res, err
+		}
+		if arg.GoodChars != "" {
+			var err error
+			if arg.goodChars, err = regexp.Compile("^" + arg.GoodChars + "*$"); err != nil {
+				return res, err
+			}
+		}
+		if arg.Strings != "" {
+			arg.choices = strings.Split(arg.Strings, "|")
+		}
+		res.args = append(res.args, arg)
+	}
+	res.prefix = strings.Join(prefix, " ")
+	return res, nil
+}
+
+func (s cmdSig) validate(args map[string]any) error {
+	for name, val := range args {
+		if slices.ContainsFunc(s.args, func(a cmdArg) bool { return a.Name == name }) {
+			continue
+		}
+		// format is accepted by all commands
+		if name != "format" {
+			return fmt.Errorf("unknown argument %q", name)
+		}
+		if _, ok := val.(string); !ok {
+			return fmt.Errorf("argument \"format\" must be a string")
+		}
+	}
+	for _, arg := range s.args {
+		val, ok := args[arg.Name]
+		if !ok || val == nil {
+			if arg.Req == nil || *arg.Req {
+				return fmt.Errorf("argument %q is required", arg.Name)
+			}
+			continue
+		}
+		if err := arg.validate(val); err != nil {
+			return fmt.Errorf("argument %q: %w", arg.Name, err)
+		}
+	}
+	return nil
+}
+
+func (a cmdArg) validate(val any) error {
+	v := reflect.ValueOf(val)
+	if a.N == "" {
+		if v.Kind() == reflect.Slice {
+			return fmt.Errorf("single value expected")
+		}
+		return a.validateValue(v)
+	}
+	if v.Kind() != reflect.Slice {
+		return fmt.Errorf("list expected")
+	}
+	for i := 0; i < v.Len(); i++ {
+		if err := a.validateValue(v.Index(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a cmdArg) validateValue(v reflect.Value) error {
+	switch a.Type {
+	case "CephInt":
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return nil
+		}
+		return fmt.Errorf("integer expected, got %s", v.Kind())
+	case "CephFloat":
+		switch v.Kind() {
+		case reflect.Float32, reflect.Float64, reflect.Int, reflect.Int32, reflect.Int64:
+			return nil
+		}
+		return fmt.Errorf("float expected, got %s", v.Kind())
+	case "CephBool":
+		if v.Kind() != reflect.Bool {
+			return fmt.Errorf("bool expected, got %s", v.Kind())
+		}
+		return nil
+	}
+	if v.Kind() != reflect.String {
+		return fmt.Errorf("string expected, got %s", v.Kind())
+	}
+	s := v.String()
+	switch {
+	case a.Type == "CephChoices" && !slices.Contains(a.choices, s):
+		return fmt.Errorf("%q is not one of %s", s, a.Strings)
+	case a.Type == "CephPgid" && !pgidRe.MatchString(s):
+		return fmt.Errorf("%q is not a pg id", s)
+	case a.goodChars != nil && !a.goodChars.MatchString(s):
+		return fmt.Errorf("%q contains characters not matching %s", s, a.GoodChars)
+	}
+	return nil
+}
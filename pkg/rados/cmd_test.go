@@ -0,0 +1,170 @@
+package rados
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MonCmd_Build(t *testing.T) {
+	r := require.New(t)
+	cmd, err := MonCmd{Prefix: "auth del", Args: map[string]any{"entity": `client.a", "caps": "x`}}.Build()
+	r.NoError(err)
+	var args map[string]any
+	r.NoError(json.Unmarshal([]byte(cmd), &args))
+	r.Equal(map[string]any{"prefix": "auth del", "entity": `client.a", "caps": "x`}, args, "quotes cannot inject arguments")
+
+	cmd, err = MonCmd{Prefix: "auth add", Args: map[string]any{"entity": "client.a", "caps": []string{"mon", "allow r"}, "format": "json"}}.Build()
+	r.NoError(err)
+	r.JSONEq(`{"prefix": "auth add", "entity": "client.a", "caps": ["mon", "allow r"], "format": "json"}`, cmd)
+	_, err = MonCmd{Prefix: "auth ls"}.Build()
+	r.NoError(err)
+	_, err = MonCmd{Prefix: "osd crush rule create-replicated", Args: map[string]any{"name": "r", "root": "default", "type": "host"}}.Build()
+	r.NoError(err)
+	_, err = MonCmd{Prefix: "balancer mode", Args: map[string]any{"mode": "upmap"}}.Build()
+	r.NoError(err, "mgr commands are validated too")
+	_, err = MonCmd{Prefix: "mon dump", Args: map[string]any{"epoch": 3}}.Build()
+	r.NoError(err)
+
+	for name, cmd := range map[string]MonCmd{
+		"required":  {Prefix: "auth del"},
+		"unknown":   {Prefix: "auth del", Args: map[string]any{"entity": "client.a", "caps": []string{"mon", "allow r"}}},
+		"type":      {Prefix: "mon dump", Args: map[string]any{"epoch": "3"}},
+		"list":      {Prefix: "auth caps", Args: map[string]any{"entity": "client.a", "caps": "mon"}},
+		"single":    {Prefix: "auth del", Args: map[string]any{"entity": []string{"client.a"}}},
+		"choice":    {Prefix: "balancer mode", Args: map[string]any{"mode": "fast"}},
+		"goodchars": {Prefix: "osd crush rule rm", Args: map[string]any{"name": `a"b`}},
+		"format":    {Prefix: "status", Args: map[string]any{"format": 1}},
+	} {
+		_, err = cmd.Build()
+		r.ErrorIs(err, types.ErrInvalidArg, name)
+	}
+	_, err = MonCmd{Prefix: "auth del all"}.Build()
+	r.ErrorIs(err, types.ErrInternal)
+}
+
+// Test_MonCmd_sources checks that every mon and mgr command sent by API code has a known signature.
+// Constant JSON commands are validated as they are, for commands built with MonCmd prefixes and argument names are checked.
+func Test_MonCmd_sources(t *testing.T) {
+	r := require.New(t)
+	var files []string
+	for _, dir := range []string{"../../pkg", "../../cmd"} {
+		r.NoError(filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err == nil && !d.IsDir() && strings.HasSuffix(path, ".go") && !strings.HasSuffix(path, "_test.go") {
+				files = append(files, path)
+			}
+			return err
+		}))
+	}
+	argNames := func(prefixes []string) []string {
+		var res []string
+		for _, prefix := range prefixes {
+			for _, sig := range cmdSigs[prefix] {
+				for _, arg := range sig.args {
+					res = append(res, arg.Name)
+				}
+			}
+		}
+		return append(res, "format")
+	}
+
+	found := 0
+	for _, file := range files {
+		f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+		r.NoError(err)
+		for _, decl := range f.Decls {
+			// prefixes and argument names used in declaration, arguments can be added after MonCmd is created
+			var prefixes, args []string
+			ast.Inspect(decl, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.BasicLit:
+					val, err := strconv.Unquote(n.Value)
+					if n.Kind != token.STRING || err != nil || !strings.Contains(val, `"prefix"`) {
+						return true
+					}
+					dec := json.NewDecoder(strings.NewReader(val))
+					dec.UseNumber()
+					var cmd map[string]any
+					if dec.Decode(&cmd) != nil {
+						return true
+					}
+					prefix, _ := cmd["prefix"].(string)
+					delete(cmd, "prefix")
+					for k, v := range cmd {
+						if num, ok := v.(json.Number); ok {
+							if cmd[k], err = num.Int64(); err != nil {
+								cmd[k], _ = num.Float64()
+							}
+						}
+					}
+					r.NoError(MonCmd{Prefix: prefix, Args: cmd}.Validate(), "%s: %s", file, val)
+					found++
+				case *ast.CompositeLit:
+					if !isMonCmdType(n.Type) {
+						return true
+					}
+					for _, elt := range n.Elts {
+						kv := elt.(*ast.KeyValueExpr)
+						switch kv.Key.(*ast.Ident).Name {
+						case "Prefix":
+							prefixes = append(prefixes, stringLit(t, kv.Value))
+						case "Args":
+							if lit, ok := kv.Value.(*ast.CompositeLit); ok {
+								for _, arg := range lit.Elts {
+									args = append(args, stringLit(t, arg.(*ast.KeyValueExpr).Key))
+								}
+							}
+						}
+					}
+				case *ast.AssignStmt:
+					switch lhs := n.Lhs[0].(type) {
+					case *ast.SelectorExpr:
+						if lhs.Sel.Name == "Prefix" {
+							prefixes = append(prefixes, stringLit(t, n.Rhs[0]))
+						}
+					case *ast.IndexExpr:
+						if sel, ok := lhs.X.(*ast.SelectorExpr); ok && sel.Sel.Name == "Args" {
+							args = append(args, stringLit(t, lhs.Index))
+						}
+					}
+				}
+				return true
+			})
+			for _, prefix := range prefixes {
+				r.Contains(cmdSigs, prefix, "%s: unknown command", file)
+				found++
+			}
+			for _, arg := range args {
+				r.Contains(argNames(prefixes), arg, "%s: unknown argument of %q", file, prefixes)
+			}
+		}
+	}
+	r.Greater(found, 20, "commands are found in sources")
+}
+
+func isMonCmdType(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name == "MonCmd"
+	case *ast.SelectorExpr:
+		return e.Sel.Name == "MonCmd"
+	}
+	return false
+}
+
+func stringLit(t *testing.T, expr ast.Expr) string {
+	lit, ok := expr.(*ast.BasicLit)
+	require.True(t, ok && lit.Kind == token.STRING, "command prefix and argument names must be string literals")
+	res, err := strconv.Unquote(lit.Value)
+	require.NoError(t, err)
+	return res
+}
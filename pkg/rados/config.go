@@ -1,7 +1,29 @@
 package rados
 
+import "time"
+
 type Config struct {
 	User        string `yaml:"user"`
 	UserKeyring string `yaml:"userKeyring"`
 	MonHost     string `yaml:"monHost"`
+	// RecordDir - if set, every executed mon/mgr command and its result is stored as a fixture file in this directory.
+	RecordDir string `yaml:"recordDir"`
+	// ReplayDir - if set, no cluster connection is established and commands are served from fixtures in this directory.
+	ReplayDir string `yaml:"replayDir"`
+
+	MonOpTimeout        time.Duration `yaml:"monOpTimeout"`
+	OsdOpTimeout        time.Duration `yaml:"osdOpTimeout"`
+	ClientMountTimeout  time.Duration `yaml:"clientMountTimeout"`
+	HealthCheckInterval time.Duration `yaml:"healthCheckInterval"`
+	// ReconnectThreshold - number of consecutive failed health checks after which connection will be re-created.
+	ReconnectThreshold int `yaml:"reconnectThreshold"`
+
+	// Connections - number of RADOS connections. Commands are distributed between them in round-robin manner.
+	Connections int `yaml:"connections"`
+	// MaxReadCommands - max number of concurrently executed read commands. Other commands wait in queue. 0 - unlimited.
+	MaxReadCommands int `yaml:"maxReadCommands"`
+	// MaxWriteCommands - max number of concurrently executed write commands. Other commands wait in queue. 0 - unlimited.
+	MaxWriteCommands int `yaml:"maxWriteCommands"`
+	// Cache - read commands cache config.
+	Cache CacheConfig `yaml:"cache"`
 }
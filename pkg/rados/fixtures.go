@@ -0,0 +1,197 @@
+package rados
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ceph/go-ceph/rados"
+)
+
+const (
+	cmdKindMon = "mon"
+	cmdKindMgr = "mgr"
+)
+
+var ErrNoFixture = errors.New("no fixture recorded for command")
+
+// Fixture is a recorded mon or mgr command execution.
+type Fixture struct {
+	Kind      string          `json:"kind"`
+	Cmd       json.RawMessage `json:"cmd"`
+	Input     []byte          `json:"input,omitempty"`
+	Result    json.RawMessage `json:"result,omitempty"`
+	ResultRaw []byte          `json:"result_raw,omitempty"`
+	Status    string          `json:"status,omitempty"`
+	Error     string          `json:"error,omitempty"`
+	ErrorCode int             `json:"error_code,omitempty"`
+}
+
+func newFixture(kind string, cmd, input, res []byte, status string, err error) Fixture {
+	f := Fixture{
+		Kind:   kind,
+		Cmd:    canonicalCmd(cmd),
+		Input:  input,
+		Status: status,
+	}
+	if json.Valid(res) {
+		f.Result = res
+	} else {
+		f.ResultRaw = res
+	}
+	if err != nil {
+		f.Error = err.Error()
+		var coded interface{ ErrorCode() int }
+		if errors.As(err, &coded) {
+			f.ErrorCode = coded.ErrorCode()
+		}
+	}
+	return f
+}
+
+func (f Fixture) result() ([]byte, string, error) {
+	res := []byte(f.Result)
+	if len(f.ResultRaw) != 0 {
+		res = f.ResultRaw
+	}
+	if f.Error == "" {
+		return res, f.Status, nil
+	}
+	switch f.ErrorCode {
+	case rados.ErrNotFound.ErrorCode():
+		return res, f.Status, rados.ErrNotFound
+	case rados.ErrPermissionDenied.ErrorCode():
+		return res, f.Status, rados.ErrPermissionDenied
+	case rados.ErrObjectExists.ErrorCode():
+		return res, f.Status, rados.ErrObjectExists
+	}
+	return res, f.Status, &fixtureError{msg: f.Error, code: f.ErrorCode}
+}
+
+type fixtureError struct {
+	msg  string
+	code int
+}
+
+func (e *fixtureError) Error() string {
+	return e.msg
+}
+
+func (e *fixtureError) ErrorCode() int {
+	return e.code
+}
+
+// fixtureName returns file name for command. Commands are compared by their JSON content,
+// so formatting and field order do not matter.
+func fixtureName(kind string, cmd, input []byte) string {
+	h := sha256.New()
+	h.Write([]byte(kind))
+	h.Write(canonicalCmd(cmd))
+	h.Write(input)
+	return kind + "-" + hex.EncodeToString(h.Sum(nil))[:16] + ".json"
+}
+
+func canonicalCmd(cmd []byte) []byte {
+	var v any
+	if err := json.Unmarshal(cmd, &v); err != nil {
+		return cmd
+	}
+	res, err := json.Marshal(v)
+	if err != nil {
+		return cmd
+	}
+	return res
+}
+
+// NewRecordConn returns Conn decorator storing every executed command with its result to fixture dir.
+// Only the latest result is kept for identical commands.
+func NewRecordConn(conn Conn, dir string) (Conn, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("%w: unable to create fixture dir %q", err, dir)
+	}
+	return &recordConn{conn: conn, dir: dir}, nil
+}
+
+type recordConn struct {
+	sync.Mutex
+	conn Conn
+	dir  string
+}
+
+func (r *recordConn) MonCommand(args []byte) ([]byte, string, error) {
+	res, status, err := r.conn.MonCommand(args)
+	r.record(newFixture(cmdKindMon, args, nil, res, status, err), nil)
+	return res, status, err
+}
+
+func (r *recordConn) MonCommandWithInputBuffer(args, inputBuffer []byte) ([]byte, string, error) {
+	res, status, err := r.conn.MonCommandWithInputBuffer(args, inputBuffer)
+	r.record(newFixture(cmdKindMon, args, inputBuffer, res, status, err), inputBuffer)
+	return res, status, err
+}
+
+func (r *recordConn) MgrCommand(args [][]byte) ([]byte, string, error) {
+	res, status, err := r.conn.MgrCommand(args)
+	r.record(newFixture(cmdKindMgr, bytes.Join(args, nil), nil, res, status, err), nil)
+	return res, status, err
+}
+
+func (r *recordConn) Shutdown() {
+	r.conn.Shutdown()
+}
+
+func (r *recordConn) record(f Fixture, input []byte) {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	// recording is best effort and must not affect command result
+	_ = os.WriteFile(filepath.Join(r.dir, fixtureName(f.Kind, f.Cmd, input)), data, 0o600)
+}
+
+// NewReplayConn returns Conn serving command results from fixtures previously stored by NewRecordConn.
+// Commands without fixture return ErrNoFixture.
+func NewReplayConn(dir string) Conn {
+	return &replayConn{dir: dir}
+}
+
+type replayConn struct {
+	dir string
+}
+
+func (r *replayConn) MonCommand(args []byte) ([]byte, string, error) {
+	return r.replay(cmdKindMon, args, nil)
+}
+
+func (r *replayConn) MonCommandWithInputBuffer(args, inputBuffer []byte) ([]byte, string, error) {
+	return r.replay(cmdKindMon, args, inputBuffer)
+}
+
+func (r *replayConn) MgrCommand(args [][]byte) ([]byte, string, error) {
+	return r.replay(cmdKindMgr, bytes.Join(args, nil), nil)
+}
+
+func (r *replayConn) Shutdown() {}
+
+func (r *replayConn) replay(kind string, cmd, input []byte) ([]byte, string, error) {
+	data, err := os.ReadFile(filepath.Join(r.dir, fixtureName(kind, cmd, input)))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, "", fmt.Errorf("%w: %s command %s", ErrNoFixture, kind, string(cmd))
+		}
+		return nil, "", err
+	}
+	var f Fixture
+	if err = json.Unmarshal(data, &f); err != nil {
+		return nil, "", fmt.Errorf("%w: unable to parse fixture for %s command %s", err, kind, string(cmd))
+	}
+	return f.result()
+}
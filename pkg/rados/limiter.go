@@ -0,0 +1,118 @@
+package rados
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+type cmdClass string
+
+const (
+	cmdClassRead  cmdClass = "read"
+	cmdClassWrite cmdClass = "write"
+)
+
+var (
+	queueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ceph_api",
+		Subsystem: "rados",
+		Name:      "queue_depth",
+		Help:      "Number of commands waiting for free execution slot.",
+	}, []string{"class"})
+	inFlightCmds = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ceph_api",
+		Subsystem: "rados",
+		Name:      "in_flight_commands",
+		Help:      "Number of commands currently executed by Ceph.",
+	}, []string{"class"})
+	queueWait = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "ceph_api",
+		Subsystem: "rados",
+		Name:      "queue_wait_seconds",
+		Help:      "Time commands spent waiting for free execution slot.",
+		Buckets:   []float64{0.001, 0.01, 0.05, 0.1, 0.5, 1, 2.5, 5, 10},
+	}, []string{"class"})
+)
+
+// readCmdSuffixes - last words of mon/mgr command prefixes which do not change cluster state.
+var readCmdSuffixes = map[string]struct{}{
+	"ls":     {},
+	"list":   {},
+	"dump":   {},
+	"get":    {},
+	"status": {},
+	"stat":   {},
+	"df":     {},
+	"tree":   {},
+	"export": {},
+	"query":  {},
+	"fsid":   {},
+	"health": {},
+}
+
+// classify returns command class by its prefix. Commands with input buffer and unknown commands are considered writes.
+func classify(cmd []byte, hasInput bool) cmdClass {
+	if hasInput {
+		return cmdClassWrite
+	}
+	var parsed struct {
+		Prefix string `json:"prefix"`
+	}
+	if err := json.Unmarshal(cmd, &parsed); err != nil {
+		return cmdClassWrite
+	}
+	words := strings.Fields(parsed.Prefix)
+	if len(words) == 0 {
+		return cmdClassWrite
+	}
+	if _, ok := readCmdSuffixes[words[len(words)-1]]; ok {
+		return cmdClassRead
+	}
+	return cmdClassWrite
+}
+
+// limiter bounds number of concurrently executed commands of the same class.
+type limiter struct {
+	class cmdClass
+	// slots is nil for unlimited concurrency
+	slots chan struct{}
+}
+
+func newLimiter(class cmdClass, max int) *limiter {
+	res := &limiter{class: class}
+	if max > 0 {
+		res.slots = make(chan struct{}, max)
+	}
+	return res
+}
+
+// acquire waits for free slot or ctx cancellation. Returned release func must be called when command is done.
+func (l *limiter) acquire(ctx context.Context) (release func(), err error) {
+	class := string(l.class)
+	if l.slots != nil {
+		start := time.Now()
+		queueDepth.WithLabelValues(class).Inc()
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case l.slots <- struct{}{}:
+		}
+		queueDepth.WithLabelValues(class).Dec()
+		queueWait.WithLabelValues(class).Observe(time.Since(start).Seconds())
+		if err != nil {
+			return nil, err
+		}
+	}
+	inFlightCmds.WithLabelValues(class).Inc()
+	return func() {
+		inFlightCmds.WithLabelValues(class).Dec()
+		if l.slots != nil {
+			<-l.slots
+		}
+	}, nil
+}
@@ -2,17 +2,122 @@ package rados
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/ceph/go-ceph/rados"
+	"github.com/clyso/ceph-api/pkg/log"
+	"github.com/clyso/ceph-api/pkg/types"
 	"github.com/rs/zerolog"
 )
 
+const healthCheckMonCmd = `{"prefix": "fsid", "format": "json"}`
+
+var ErrNotConnected = fmt.Errorf("%w: RADOS connection is not ready", types.ErrUnavailable)
+
+// Conn is a subset of go-ceph rados connection methods used to execute commands.
+type Conn interface {
+	MonCommand(args []byte) ([]byte, string, error)
+	MonCommandWithInputBuffer(args, inputBuffer []byte) ([]byte, string, error)
+	MgrCommand(args [][]byte) ([]byte, string, error)
+	Shutdown()
+}
+
 type Svc struct {
-	conn *rados.Conn
+	mu sync.RWMutex
+	// conns - pool of connections used in round-robin manner
+	conns []*connHolder
+	next  atomic.Uint64
+	ready atomic.Bool
+
+	readLimiter  *limiter
+	writeLimiter *limiter
+	// cache is nil if read cache is disabled
+	cache *cache
+
+	// dial creates a new connection. It is nil if connection cannot be re-created.
+	dial                func() (Conn, error)
+	healthCheckInterval time.Duration
+	reconnectThreshold  int
+}
+
+// connHolder tracks in-flight commands so that connection is shut down only after all of them are done.
+type connHolder struct {
+	conn     Conn
+	inFlight sync.WaitGroup
 }
 
 func New(conf Config) (*Svc, error) {
+	if conf.ReplayDir != "" {
+		res := NewWithConn(NewReplayConn(conf.ReplayDir))
+		res.setLimits(conf)
+		res.cache = newCache(conf.Cache)
+		return res, nil
+	}
+	dial := func() (Conn, error) {
+		conn, err := connect(conf)
+		if err != nil {
+			return nil, err
+		}
+		if conf.RecordDir == "" {
+			return conn, nil
+		}
+		rec, err := NewRecordConn(conn, conf.RecordDir)
+		if err != nil {
+			conn.Shutdown()
+			return nil, err
+		}
+		return rec, nil
+	}
+	poolSize := conf.Connections
+	if poolSize < 1 {
+		poolSize = 1
+	}
+	conns := make([]Conn, 0, poolSize)
+	for i := 0; i < poolSize; i++ {
+		conn, err := dial()
+		if err != nil {
+			for _, c := range conns {
+				c.Shutdown()
+			}
+			return nil, err
+		}
+		conns = append(conns, conn)
+	}
+	res := NewWithConn(conns...)
+	res.setLimits(conf)
+	res.cache = newCache(conf.Cache)
+	res.dial = dial
+	res.healthCheckInterval = conf.HealthCheckInterval
+	res.reconnectThreshold = conf.ReconnectThreshold
+	if res.reconnectThreshold < 1 {
+		res.reconnectThreshold = 1
+	}
+	return res, nil
+}
+
+// NewWithConn returns Svc executing commands on given connections without concurrency limits.
+func NewWithConn(conns ...Conn) *Svc {
+	res := &Svc{
+		readLimiter:  newLimiter(cmdClassRead, 0),
+		writeLimiter: newLimiter(cmdClassWrite, 0),
+	}
+	for _, conn := range conns {
+		res.conns = append(res.conns, &connHolder{conn: conn})
+	}
+	res.ready.Store(true)
+	return res
+}
+
+func (s *Svc) setLimits(conf Config) {
+	s.readLimiter = newLimiter(cmdClassRead, conf.MaxReadCommands)
+	s.writeLimiter = newLimiter(cmdClassWrite, conf.MaxWriteCommands)
+}
+
+func connect(conf Config) (*rados.Conn, error) {
 	conn, err := rados.NewConnWithUser(conf.User)
 	if err != nil {
 		return nil, err
@@ -20,36 +125,205 @@ func New(conf Config) (*Svc, error) {
 	if conf.MonHost == "" || conf.UserKeyring == "" {
 		err = conn.ReadDefaultConfigFile()
 	} else {
-		err = conn.ParseCmdLineArgs([]string{"--mon-host", conf.MonHost, "--key", conf.UserKeyring, "--client_mount_timeout", "3"})
+		err = conn.ParseCmdLineArgs([]string{"--mon-host", conf.MonHost, "--key", conf.UserKeyring})
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	timeout := strconv.FormatFloat(3, 'f', -1, 64)
+	timeouts := map[string]time.Duration{
+		"client_mount_timeout": conf.ClientMountTimeout,
+		"rados_osd_op_timeout": conf.OsdOpTimeout,
+		"rados_mon_op_timeout": conf.MonOpTimeout,
+	}
+	for opt, timeout := range timeouts {
+		if timeout <= 0 {
+			timeout = 3 * time.Second
+		}
+		err = conn.SetConfigOption(opt, strconv.FormatFloat(timeout.Seconds(), 'f', -1, 64))
+		if err != nil {
+			return nil, fmt.Errorf("%w: unable to set rados option %s", err, opt)
+		}
+	}
 
-	err = conn.SetConfigOption("rados_osd_op_timeout", timeout)
+	err = conn.Connect()
 	if err != nil {
 		return nil, err
 	}
+	return conn, nil
+}
 
-	err = conn.SetConfigOption("rados_mon_op_timeout", timeout)
+// Ready returns true if the last RADOS connection health check succeeded.
+func (s *Svc) Ready() bool {
+	return s.ready.Load()
+}
+
+// HealthCheck periodically probes RADOS connections and re-creates connection after reconnectThreshold failed probes.
+// Blocks until ctx is done.
+func (s *Svc) HealthCheck(ctx context.Context) error {
+	if s.dial == nil || s.healthCheckInterval <= 0 {
+		<-ctx.Done()
+		return nil
+	}
+	logger := zerolog.Ctx(ctx)
+	ticker := time.NewTicker(s.healthCheckInterval)
+	defer ticker.Stop()
+	s.mu.RLock()
+	failed := make([]int, len(s.conns))
+	s.mu.RUnlock()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		ready := true
+		for i := range failed {
+			if !s.probe(ctx, i, &failed[i]) {
+				ready = false
+			}
+		}
+		s.ready.Store(ready)
+		if !ready {
+			logger.Warn().Ints("failed_checks", failed).Msg("rados connection is not ready")
+		}
+	}
+}
+
+// probe checks i-th connection and returns true if it is healthy.
+func (s *Svc) probe(ctx context.Context, i int, failed *int) bool {
+	logger := zerolog.Ctx(ctx).With().Int("rados_conn", i).Logger()
+	s.mu.RLock()
+	if i >= len(s.conns) {
+		// service closed
+		s.mu.RUnlock()
+		return false
+	}
+	holder := s.conns[i]
+	// registered under lock, so reconnect and Close wait for probe before connection shutdown
+	holder.inFlight.Add(1)
+	s.mu.RUnlock()
+	probeCtx, cancel := context.WithTimeout(ctx, s.healthCheckInterval)
+	_, _, err := s.execOn(probeCtx, holder, func(conn Conn) ([]byte, string, error) {
+		return conn.MonCommand([]byte(healthCheckMonCmd))
+	})
+	cancel()
+	if err == nil {
+		if *failed != 0 {
+			logger.Info().Int("failed_checks", *failed).Msg("rados connection restored")
+		}
+		*failed = 0
+		return true
+	}
+	*failed++
+	logger.Warn().Err(err).Int("failed_checks", *failed).Msg("rados connection health check failed")
+	if *failed < s.reconnectThreshold {
+		return false
+	}
+	if err = s.reconnect(i); err != nil {
+		logger.Err(err).Msg("unable to reconnect to rados")
+		return false
+	}
+	logger.Info().Msg("rados connection re-created")
+	*failed = 0
+	return true
+}
+
+func (s *Svc) reconnect(i int) error {
+	conn, err := s.dial()
 	if err != nil {
-		return nil, err
+		return err
 	}
+	s.mu.Lock()
+	prev := s.conns[i]
+	s.conns[i] = &connHolder{conn: conn}
+	s.mu.Unlock()
+	go func() {
+		prev.inFlight.Wait()
+		prev.conn.Shutdown()
+	}()
+	return nil
+}
 
-	err = conn.Connect()
+// exec waits for free slot for command class and runs command on the next pool connection.
+// RADOS commands cannot be cancelled, so on ctx cancellation the command is abandoned and left to finish in background.
+// Execution slot is released only when abandoned command is done to not overload Ceph.
+func (s *Svc) exec(ctx context.Context, class cmdClass, fn func(conn Conn) ([]byte, string, error)) ([]byte, string, error) {
+	l := s.readLimiter
+	if class == cmdClassWrite {
+		l = s.writeLimiter
+	}
+	release, err := l.acquire(ctx)
 	if err != nil {
-		return nil, err
+		return nil, "", fmt.Errorf("%w: rados command was not executed", err)
+	}
+	s.mu.RLock()
+	if len(s.conns) == 0 {
+		s.mu.RUnlock()
+		release()
+		return nil, "", ErrNotConnected
 	}
-	return &Svc{conn: conn}, nil
+	holder := s.conns[s.next.Add(1)%uint64(len(s.conns))]
+	// registered under lock, so reconnect and Close wait for command before connection shutdown
+	holder.inFlight.Add(1)
+	s.mu.RUnlock()
+	return s.execOn(ctx, holder, fn, release)
+}
+
+// execOn runs command on connection. Caller must add command to holder.inFlight while holding s.mu.
+func (s *Svc) execOn(ctx context.Context, holder *connHolder, fn func(conn Conn) ([]byte, string, error), onDone ...func()) ([]byte, string, error) {
+	if err := ctx.Err(); err != nil {
+		holder.inFlight.Done()
+		for _, f := range onDone {
+			f()
+		}
+		return nil, "", err
+	}
+	type result struct {
+		res    []byte
+		status string
+		err    error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		defer holder.inFlight.Done()
+		res, status, err := fn(holder.conn)
+		for _, f := range onDone {
+			f()
+		}
+		resCh <- result{res: res, status: status, err: err}
+	}()
+	select {
+	case <-ctx.Done():
+		return nil, "", fmt.Errorf("%w: rados command abandoned", ctx.Err())
+	case r := <-resCh:
+		return r.res, r.status, r.err
+	}
+}
+
+// execCached serves read commands from cache if enabled. Write commands invalidate cache.
+func (s *Svc) execCached(ctx context.Context, kind, cmd string, class cmdClass, fn func(conn Conn) ([]byte, string, error)) ([]byte, string, error) {
+	if s.cache == nil {
+		return s.exec(ctx, class, fn)
+	}
+	if class == cmdClassWrite {
+		// invalidate before to not store results of concurrent reads and after to drop results read during the write
+		s.cache.invalidate(cmd)
+		defer s.cache.invalidate(cmd)
+		return s.exec(ctx, class, fn)
+	}
+	return s.cache.get(ctx, kind, cmd, func(ctx context.Context) ([]byte, string, error) {
+		return s.exec(ctx, class, fn)
+	})
 }
 
 func (s *Svc) ExecMon(ctx context.Context, cmd string) ([]byte, error) {
-	logger := zerolog.Ctx(ctx).With().Str("mon_cmd", cmd).Logger()
+	logger := zerolog.Ctx(ctx).With().Str("mon_cmd", log.RedactCmd(cmd)).Logger()
 
 	logger.Debug().Msg("executing mon command")
-	cmdRes, cmdStatus, err := s.conn.MonCommand([]byte(cmd))
+	cmdRes, cmdStatus, err := s.execCached(ctx, cmdKindMon, cmd, classify([]byte(cmd), false), func(conn Conn) ([]byte, string, error) {
+		return conn.MonCommand([]byte(cmd))
+	})
 	if err != nil {
 		logger.Err(err).Str("cmd_status", cmdStatus).Msg("mon command executed with error")
 		return nil, err
@@ -57,15 +331,21 @@ func (s *Svc) ExecMon(ctx context.Context, cmd string) ([]byte, error) {
 	if cmdStatus != "" {
 		logger.Info().Str("cmd_status", cmdStatus).Msg("mon command executed with status")
 	}
-	logger.Debug().Str("mod_cmd_res", string(cmdRes)).Msg("mon command executed with success")
+	if e := logger.Debug(); e.Enabled() {
+		e.Str("mod_cmd_res", log.RedactCmdData(cmd, cmdRes)).Msg("mon command executed with success")
+	}
 	return cmdRes, nil
 }
 
 func (s *Svc) ExecMonWithInputBuff(ctx context.Context, cmd string, inputBuffer []byte) ([]byte, error) {
-	logger := zerolog.Ctx(ctx).With().Str("mon_cmd", cmd).Logger()
+	logger := zerolog.Ctx(ctx).With().Str("mon_cmd", log.RedactCmd(cmd)).Logger()
 
-	logger.Debug().Str("mon_cmd_buf", string(inputBuffer)).Msg("executing mon command with input buffer")
-	cmdRes, cmdStatus, err := s.conn.MonCommandWithInputBuffer([]byte(cmd), inputBuffer)
+	if e := logger.Debug(); e.Enabled() {
+		e.Str("mon_cmd_buf", log.RedactCmdData(cmd, inputBuffer)).Msg("executing mon command with input buffer")
+	}
+	cmdRes, cmdStatus, err := s.execCached(ctx, cmdKindMon, cmd, cmdClassWrite, func(conn Conn) ([]byte, string, error) {
+		return conn.MonCommandWithInputBuffer([]byte(cmd), inputBuffer)
+	})
 	if err != nil {
 		logger.Err(err).Str("cmd_status", cmdStatus).Msg("mon command with input buffer executed with error")
 		return nil, err
@@ -73,15 +353,19 @@ func (s *Svc) ExecMonWithInputBuff(ctx context.Context, cmd string, inputBuffer
 	if cmdStatus != "" {
 		logger.Info().Str("cmd_status", cmdStatus).Msg("mon command with input buffer executed with status")
 	}
-	logger.Debug().Str("mod_cmd_res", string(cmdRes)).Msg("mon command with input buffer executed with success")
+	if e := logger.Debug(); e.Enabled() {
+		e.Str("mod_cmd_res", log.RedactCmdData(cmd, cmdRes)).Msg("mon command with input buffer executed with success")
+	}
 	return cmdRes, nil
 }
 
 func (s *Svc) ExecMgr(ctx context.Context, cmd string) ([]byte, error) {
-	logger := zerolog.Ctx(ctx).With().Str("mon_cmd", cmd).Logger()
+	logger := zerolog.Ctx(ctx).With().Str("mon_cmd", log.RedactCmd(cmd)).Logger()
 
 	logger.Debug().Msg("executing mgr command")
-	cmdRes, cmdStatus, err := s.conn.MgrCommand([][]byte{[]byte(cmd)})
+	cmdRes, cmdStatus, err := s.execCached(ctx, cmdKindMgr, cmd, classify([]byte(cmd), false), func(conn Conn) ([]byte, string, error) {
+		return conn.MgrCommand([][]byte{[]byte(cmd)})
+	})
 	if err != nil {
 		logger.Err(err).Str("cmd_status", cmdStatus).Msg("mgr command executed with error")
 		return nil, err
@@ -89,10 +373,20 @@ func (s *Svc) ExecMgr(ctx context.Context, cmd string) ([]byte, error) {
 	if cmdStatus != "" {
 		logger.Info().Str("cmd_status", cmdStatus).Msg("mgr command executed with status")
 	}
-	logger.Debug().Str("mgr_cmd_res", string(cmdRes)).Msg("mgr command executed with success")
+	if e := logger.Debug(); e.Enabled() {
+		e.Str("mgr_cmd_res", log.RedactCmdData(cmd, cmdRes)).Msg("mgr command executed with success")
+	}
 	return cmdRes, nil
 }
 
 func (s *Svc) Close() {
-	s.conn.Shutdown()
+	s.mu.Lock()
+	conns := s.conns
+	s.conns = nil
+	s.mu.Unlock()
+	s.ready.Store(false)
+	for _, holder := range conns {
+		holder.inFlight.Wait()
+		holder.conn.Shutdown()
+	}
 }
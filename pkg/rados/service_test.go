@@ -0,0 +1,210 @@
+package rados
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ceph/go-ceph/rados"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type blockingConn struct {
+	fakeConn
+	release  chan struct{}
+	shutdown atomic.Bool
+}
+
+func (b *blockingConn) MonCommand(args []byte) ([]byte, string, error) {
+	<-b.release
+	return b.fakeConn.MonCommand(args)
+}
+
+func (b *blockingConn) Shutdown() {
+	b.shutdown.Store(true)
+}
+
+func Test_ExecMon_ContextDeadline(t *testing.T) {
+	r := require.New(t)
+	conn := &blockingConn{release: make(chan struct{})}
+	svc := NewWithConn(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := svc.ExecMon(ctx, `{"prefix": "osd dump"}`)
+	r.ErrorIs(err, context.DeadlineExceeded)
+
+	// connection is shut down only after abandoned command is done
+	closed := make(chan struct{})
+	go func() {
+		svc.Close()
+		close(closed)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	r.False(conn.shutdown.Load())
+	close(conn.release)
+	<-closed
+	r.True(conn.shutdown.Load())
+
+	_, err = svc.ExecMon(context.Background(), `{"prefix": "osd dump"}`)
+	r.ErrorIs(err, ErrNotConnected)
+}
+
+type failingConn struct {
+	fakeConn
+	shutdown atomic.Bool
+}
+
+func (f *failingConn) MonCommand(args []byte) ([]byte, string, error) {
+	return nil, "", rados.ErrNotConnected
+}
+
+func (f *failingConn) Shutdown() {
+	f.shutdown.Store(true)
+}
+
+func Test_HealthCheck_Reconnect(t *testing.T) {
+	r := require.New(t)
+	broken := &failingConn{}
+	healthy := &fakeConn{res: map[string][]byte{healthCheckMonCmd: []byte(`{"fsid": "id"}`)}}
+	svc := NewWithConn(broken)
+	svc.healthCheckInterval = 10 * time.Millisecond
+	svc.reconnectThreshold = 2
+	var dials atomic.Int32
+	svc.dial = func() (Conn, error) {
+		dials.Add(1)
+		return healthy, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		_ = svc.HealthCheck(ctx)
+		close(done)
+	}()
+	r.Eventually(func() bool {
+		return dials.Load() == 1 && broken.shutdown.Load()
+	}, time.Second, 5*time.Millisecond)
+	r.Eventually(svc.Ready, time.Second, 5*time.Millisecond)
+	res, err := svc.ExecMon(ctx, healthCheckMonCmd)
+	r.NoError(err)
+	r.JSONEq(`{"fsid": "id"}`, string(res))
+	cancel()
+	<-done
+}
+
+// shutdownConn counts commands sent after connection shutdown.
+type shutdownConn struct {
+	fakeConn
+	shutdown    atomic.Bool
+	afterClosed atomic.Int32
+}
+
+func (c *shutdownConn) MonCommand(args []byte) ([]byte, string, error) {
+	if c.shutdown.Load() {
+		c.afterClosed.Add(1)
+	}
+	return c.fakeConn.MonCommand(args)
+}
+
+func (c *shutdownConn) Shutdown() {
+	c.shutdown.Store(true)
+}
+
+func Test_Reconnect_InFlight(t *testing.T) {
+	r := require.New(t)
+	res := map[string][]byte{healthCheckMonCmd: []byte(`{"fsid": "id"}`)}
+	var conns []*shutdownConn
+	newConn := func() *shutdownConn {
+		c := &shutdownConn{fakeConn: fakeConn{res: res}}
+		conns = append(conns, c)
+		return c
+	}
+	svc := NewWithConn(newConn())
+	svc.dial = func() (Conn, error) {
+		return newConn(), nil
+	}
+
+	ctx := context.Background()
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	for i := 0; i < 8; i++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				_, _ = svc.ExecMon(ctx, healthCheckMonCmd)
+			}
+		}()
+	}
+	for i := 0; i < 100; i++ {
+		r.NoError(svc.reconnect(0))
+	}
+	close(stop)
+	for i := 0; i < 8; i++ {
+		<-done
+	}
+	svc.Close()
+	for _, c := range conns {
+		r.Zero(c.afterClosed.Load(), "command was not sent to closed connection")
+	}
+}
+
+func Test_Limiter(t *testing.T) {
+	r := require.New(t)
+	r.EqualValues(cmdClassRead, classify([]byte(`{"prefix": "osd dump", "format": "json"}`), false))
+	r.EqualValues(cmdClassRead, classify([]byte(`{"prefix": "config-key get", "key": "k"}`), false))
+	r.EqualValues(cmdClassWrite, classify([]byte(`{"prefix": "config-key set", "key": "k"}`), true))
+	r.EqualValues(cmdClassWrite, classify([]byte(`{"prefix": "auth del", "entity": "client.a"}`), false))
+	r.EqualValues(cmdClassWrite, classify([]byte(`not json`), false))
+
+	conn := &blockingConn{release: make(chan struct{}), fakeConn: fakeConn{res: map[string][]byte{`{"prefix": "osd dump"}`: []byte(`{}`)}}}
+	svc := NewWithConn(conn)
+	svc.setLimits(Config{MaxReadCommands: 1})
+
+	// first command takes the only read slot
+	firstDone := make(chan error)
+	go func() {
+		_, err := svc.ExecMon(context.Background(), `{"prefix": "osd dump"}`)
+		firstDone <- err
+	}()
+	r.Eventually(func() bool { return len(svc.readLimiter.slots) == 1 }, time.Second, time.Millisecond)
+
+	// second command waits in queue until ctx deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := svc.ExecMon(ctx, `{"prefix": "osd dump"}`)
+	r.ErrorIs(err, context.DeadlineExceeded)
+
+	close(conn.release)
+	r.NoError(<-firstDone)
+	r.Len(svc.readLimiter.slots, 0)
+	_, err = svc.ExecMon(context.Background(), `{"prefix": "osd dump"}`)
+	r.NoError(err)
+}
+
+func Test_ExecMon_RedactsLogs(t *testing.T) {
+	r := require.New(t)
+	var buf bytes.Buffer
+	ctx := zerolog.New(&buf).Level(zerolog.DebugLevel).WithContext(context.Background())
+	svc := NewWithConn(&fakeConn{res: map[string][]byte{
+		`{"prefix": "auth export", "format": "json"}`: []byte(`[{"entity":"client.a","key":"AQD123=="}]`),
+	}})
+	defer svc.Close()
+
+	_, err := svc.ExecMonWithInputBuff(ctx, `{"prefix": "config-key set", "key": "mgr/dashboard/accessdb_v2"}`, []byte(`{"users":{"admin":{"password":"$2b$12$hash"}}}`))
+	r.NoError(err)
+	_, err = svc.ExecMon(ctx, `{"prefix": "auth export", "format": "json"}`)
+	r.NoError(err)
+	r.Contains(buf.String(), "mgr/dashboard/accessdb_v2")
+	r.Contains(buf.String(), "client.a")
+	r.NotContains(buf.String(), "$2b$12$hash")
+	r.NotContains(buf.String(), "AQD123")
+}
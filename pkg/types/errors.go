@@ -13,4 +13,9 @@ var (
 	ErrInternal        = errors.New("InternalError")
 	ErrUnauthenticated = errors.New("Unauthenticated")
 	ErrAccessDenied    = errors.New("AccessDenied")
+	ErrUnavailable     = errors.New("Unavailable")
+	// ErrAborted - request conflicts with concurrent change and can be retried.
+	ErrAborted = errors.New("Aborted")
+	// ErrPwdChangeRequired - user password is expired or must be changed by admin request.
+	ErrPwdChangeRequired = errors.New("PasswordChangeRequired")
 )
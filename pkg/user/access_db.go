@@ -0,0 +1,263 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	goceph "github.com/ceph/go-ceph/rados"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/rs/zerolog"
+)
+
+// userExtKeyPrefix - config-key prefix of user extensions, see userExt.
+const userExtKeyPrefix = "ceph-api/access_db/user/"
+
+// userExt - user fields unknown to Ceph dashboard. Dashboard drops unknown fields when it rewrites access DB,
+// so they are stored in a separate config-key per user.
+type userExt struct {
+	ExternalIssuer string   `json:"externalIssuer,omitempty"`
+	ServiceAccount bool     `json:"serviceAccount,omitempty"`
+	APIKeys        []APIKey `json:"apiKeys,omitempty"`
+	MFA            *MFA     `json:"mfa,omitempty"`
+}
+
+func (e userExt) empty() bool {
+	return e.ExternalIssuer == "" && !e.ServiceAccount && len(e.APIKeys) == 0 && e.MFA == nil
+}
+
+func (u *User) ext() userExt {
+	return userExt{ExternalIssuer: u.ExternalIssuer, ServiceAccount: u.ServiceAccount, APIKeys: u.APIKeys, MFA: u.MFA}
+}
+
+func (u *User) setExt(e userExt) {
+	u.ExternalIssuer, u.ServiceAccount, u.APIKeys, u.MFA = e.ExternalIssuer, e.ServiceAccount, e.APIKeys, e.MFA
+}
+
+// storedDB - access DB content and serialized user extensions by username as stored in config-key store.
+type storedDB struct {
+	raw []byte
+	ext map[string][]byte
+}
+
+func (d storedDB) equal(other storedDB) bool {
+	return bytes.Equal(d.raw, other.raw) && maps.EqualFunc(d.ext, other.ext, bytes.Equal)
+}
+
+// ReloadDB periodically reloads access DB changed by other API replicas or Ceph dashboard.
+// Blocks until ctx is done. Reload is disabled if interval is 0.
+func (s *Service) ReloadDB(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		<-ctx.Done()
+		return nil
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := s.reloadDB(ctx); err != nil {
+			zerolog.Ctx(ctx).Err(err).Msg("unable to reload access db")
+		}
+	}
+}
+
+func (s *Service) reloadDB(ctx context.Context) error {
+	s.RLock()
+	known := s.stored
+	s.RUnlock()
+	stored, err := s.readDB(ctx)
+	if err != nil {
+		return err
+	}
+	if stored.equal(known) {
+		return nil
+	}
+	s.Lock()
+	defer s.Unlock()
+	if !known.equal(s.stored) {
+		// access db was written by this replica during read, changes will be loaded on the next reload
+		return nil
+	}
+	if err = s.applyDB(stored); err != nil {
+		return err
+	}
+	zerolog.Ctx(ctx).Info().Msg("access db reloaded")
+	return nil
+}
+
+// readDB returns access DB content with user extensions. Content is nil if DB does not exist.
+func (s *Service) readDB(ctx context.Context) (storedDB, error) {
+	res := storedDB{ext: map[string][]byte{}}
+	raw, err := s.radosSvc.ExecMon(ctx, getDBMonCmd)
+	if err != nil && !errors.Is(err, goceph.ErrNotFound) {
+		return res, err
+	}
+	res.raw = raw
+	cmd, err := rados.MonCmd{Prefix: "config-key dump", Args: map[string]any{"key": userExtKeyPrefix}}.Build()
+	if err != nil {
+		return res, err
+	}
+	dump, err := s.radosSvc.ExecMon(ctx, cmd)
+	if err != nil {
+		return res, err
+	}
+	var vals map[string]string
+	if err = json.Unmarshal(dump, &vals); err != nil {
+		return res, err
+	}
+	for key, val := range vals {
+		if username, ok := strings.CutPrefix(key, userExtKeyPrefix); ok {
+			res.ext[username] = []byte(val)
+		}
+	}
+	return res, nil
+}
+
+// storeUserExt writes changed user extensions and removes extensions of deleted users.
+func (s *Service) storeUserExt(ctx context.Context, prev, next map[string][]byte) error {
+	for username, val := range next {
+		if bytes.Equal(val, prev[username]) {
+			continue
+		}
+		cmd, err := rados.MonCmd{Prefix: "config-key set", Args: map[string]any{"key": userExtKeyPrefix + username}}.Build()
+		if err != nil {
+			return err
+		}
+		if _, err = s.radosSvc.ExecMonWithInputBuff(ctx, cmd, val); err != nil {
+			return err
+		}
+	}
+	for username := range prev {
+		if _, ok := next[username]; ok {
+			continue
+		}
+		cmd, err := rados.MonCmd{Prefix: "config-key rm", Args: map[string]any{"key": userExtKeyPrefix + username}}.Build()
+		if err != nil {
+			return err
+		}
+		if _, err = s.radosSvc.ExecMon(ctx, cmd); err != nil && !errors.Is(err, goceph.ErrNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseDB returns access DB users with their extensions. Users without stored extension keep
+// extension fields from access DB, where they were stored before.
+func parseDB(stored storedDB) (db, error) {
+	res := db{Users: map[string]User{}, Roles: map[string]Role{}}
+	if len(stored.raw) != 0 {
+		if err := json.Unmarshal(stored.raw, &res); err != nil {
+			return res, err
+		}
+	}
+	if res.Users == nil {
+		res.Users = map[string]User{}
+	}
+	if res.Roles == nil {
+		res.Roles = map[string]Role{}
+	}
+	for username, raw := range stored.ext {
+		usr, ok := res.Users[username]
+		if !ok {
+			// user was deleted by Ceph dashboard, extension is removed on the next write
+			continue
+		}
+		var ext userExt
+		if err := json.Unmarshal(raw, &ext); err != nil {
+			return res, fmt.Errorf("%w: unable to parse user %s extension", err, username)
+		}
+		usr.setExt(ext)
+		res.Users[username] = usr
+	}
+	return res, nil
+}
+
+// applyDB replaces in-memory state with given access DB content. Must be called under lock.
+func (s *Service) applyDB(stored storedDB) error {
+	res, err := parseDB(stored)
+	if err != nil {
+		return err
+	}
+	s.users, s.roles, s.stored = res.Users, res.Roles, stored
+	return nil
+}
+
+// mergeDB applies remote changes made since the last read to in-memory state. Must be called under lock.
+func (s *Service) mergeDB(ctx context.Context, remote storedDB) error {
+	base, err := parseDB(s.stored)
+	if err != nil {
+		return err
+	}
+	theirs, err := parseDB(remote)
+	if err != nil {
+		return err
+	}
+	users, err := mergeEntries("user", base.Users, s.users, theirs.Users)
+	if err != nil {
+		return err
+	}
+	roles, err := mergeEntries("role", base.Roles, s.roles, theirs.Roles)
+	if err != nil {
+		return err
+	}
+	s.users, s.roles, s.stored = users, roles, remote
+	zerolog.Ctx(ctx).Info().Msg("access db changed concurrently, changes merged")
+	return nil
+}
+
+// mergeEntries does three-way merge of users or roles. Entry changed both locally and remotely
+// in different ways is a conflict.
+func mergeEntries[T any](kind string, base, ours, theirs map[string]T) (map[string]T, error) {
+	res := make(map[string]T, len(ours))
+	keys := map[string]struct{}{}
+	for k := range ours {
+		keys[k] = struct{}{}
+	}
+	for k := range theirs {
+		keys[k] = struct{}{}
+	}
+	for k := range base {
+		keys[k] = struct{}{}
+	}
+	for k := range keys {
+		b, o, t := entryJSON(base, k), entryJSON(ours, k), entryJSON(theirs, k)
+		var src map[string]T
+		switch {
+		case bytes.Equal(o, b), bytes.Equal(o, t):
+			src = theirs
+		case bytes.Equal(t, b):
+			src = ours
+		default:
+			return nil, fmt.Errorf("%w: %s %s was changed concurrently, retry request", types.ErrAborted, kind, k)
+		}
+		if v, ok := src[k]; ok {
+			res[k] = v
+		}
+	}
+	return res, nil
+}
+
+// entryJSON returns serialized entry or nil if it does not exist.
+func entryJSON[T any](m map[string]T, key string) []byte {
+	v, ok := m[key]
+	if !ok {
+		return nil
+	}
+	res, err := json.Marshal(v)
+	if err != nil {
+		// never happens for access db entries, treat as changed
+		return []byte(err.Error())
+	}
+	return res
+}
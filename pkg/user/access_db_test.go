@@ -0,0 +1,214 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	goceph "github.com/ceph/go-ceph/rados"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// accessDBConn stores access DB and user extensions shared by services in memory.
+type accessDBConn struct {
+	mu   sync.Mutex
+	data []byte
+	// overwrite - if set, replaces data right after the next write, as concurrent writer would do.
+	overwrite []byte
+	// keys - other config-keys.
+	keys map[string][]byte
+}
+
+func (c *accessDBConn) MonCommand(args []byte) ([]byte, string, error) {
+	return c.MonCommandWithInputBuffer(args, nil)
+}
+
+func (c *accessDBConn) MonCommandWithInputBuffer(args, inputBuffer []byte) ([]byte, string, error) {
+	var cmd struct {
+		Prefix string `json:"prefix"`
+		Key    string `json:"key"`
+	}
+	if err := json.Unmarshal(args, &cmd); err != nil {
+		return nil, "", err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.keys == nil {
+		c.keys = map[string][]byte{}
+	}
+	if cmd.Prefix == "config-key dump" {
+		res := map[string]string{}
+		for k, v := range c.keys {
+			if strings.HasPrefix(k, cmd.Key) {
+				res[k] = string(v)
+			}
+		}
+		out, err := json.Marshal(res)
+		return out, "", err
+	}
+	if cmd.Key != "mgr/dashboard/accessdb_v2" {
+		switch cmd.Prefix {
+		case "config-key get":
+			if v, ok := c.keys[cmd.Key]; ok {
+				return v, "", nil
+			}
+		case "config-key set":
+			c.keys[cmd.Key] = inputBuffer
+			return nil, "", nil
+		case "config-key rm":
+			delete(c.keys, cmd.Key)
+			return nil, "", nil
+		}
+		return nil, "", goceph.ErrNotFound
+	}
+	switch {
+	case cmd.Prefix == "config-key get" && c.data != nil:
+		return c.data, "", nil
+	case cmd.Prefix == "config-key set":
+		c.data = inputBuffer
+		if c.overwrite != nil {
+			c.data, c.overwrite = c.overwrite, nil
+		}
+		return nil, "", nil
+	}
+	return nil, "", goceph.ErrNotFound
+}
+
+// dump returns all stored config-keys.
+func (c *accessDBConn) dump() map[string]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	res := map[string]string{"mgr/dashboard/accessdb_v2": string(c.data)}
+	for k, v := range c.keys {
+		res[k] = string(v)
+	}
+	return res
+}
+
+func (c *accessDBConn) MgrCommand(args [][]byte) ([]byte, string, error) {
+	return nil, "", goceph.ErrNotFound
+}
+
+func (c *accessDBConn) Shutdown() {}
+
+func Test_AccessDB_concurrentReplicas(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	conn := &accessDBConn{}
+	newSvc := func() *Service {
+		svc, err := New(rados.NewWithConn(conn), nil, PasswordPolicy{}, bcrypt.MinCost)
+		r.NoError(err)
+		return svc
+	}
+	svc1, svc2 := newSvc(), newSvc()
+
+	// changes of different users are merged
+	r.NoError(svc1.CreateUser(ctx, User{Username: "alice", Password: "secret", Roles: []string{"read-only"}, Enabled: true}))
+	r.NoError(svc2.CreateUser(ctx, User{Username: "bob", Password: "secret", Roles: []string{"read-only"}, Enabled: true}))
+	_, err := svc2.GetUser(ctx, "alice")
+	r.NoError(err, "merged on write")
+	_, err = svc1.GetUser(ctx, "bob")
+	r.ErrorIs(err, types.ErrNotFound, "not reloaded yet")
+	r.NoError(svc1.reloadDB(ctx))
+	_, err = svc1.GetUser(ctx, "bob")
+	r.NoError(err)
+
+	// conflicting change of the same user is rejected
+	r.NoError(svc1.SetEnabled(ctx, "alice", false))
+	err = svc2.UpdateUser(ctx, User{Username: "alice", Roles: []string{"administrator"}, Enabled: true}, false)
+	r.ErrorIs(err, types.ErrAborted)
+	usr, err := svc2.GetUser(ctx, "alice")
+	r.NoError(err)
+	r.False(usr.Enabled, "rolled back to stored state")
+	r.EqualValues([]string{"read-only"}, usr.Roles)
+	// retry succeeds
+	r.NoError(svc2.UpdateUser(ctx, User{Username: "alice", Roles: []string{"administrator"}, Enabled: true}, false))
+
+	// concurrent delete and update
+	r.NoError(svc1.reloadDB(ctx))
+	r.NoError(svc1.DeleteUser(ctx, "bob"))
+	r.ErrorIs(svc2.SetEnabled(ctx, "bob", false), types.ErrAborted)
+	_, err = svc2.GetUser(ctx, "bob")
+	r.ErrorIs(err, types.ErrNotFound)
+
+	// stored state contains all changes
+	r.NoError(svc1.reloadDB(ctx))
+	usr, err = svc1.GetUser(ctx, "alice")
+	r.NoError(err)
+	r.True(usr.Enabled)
+	r.EqualValues([]string{"administrator"}, usr.Roles)
+	svc3 := newSvc()
+	users, err := svc3.ListUsers(ctx)
+	r.NoError(err)
+	r.Len(users, 1)
+}
+
+func Test_AccessDB_overwrittenAfterWrite(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	conn := &accessDBConn{}
+	svc, err := New(rados.NewWithConn(conn), nil, PasswordPolicy{}, bcrypt.MinCost)
+	r.NoError(err)
+	r.NoError(svc.CreateUser(ctx, User{Username: "alice", Password: "secret", Roles: []string{"read-only"}, Enabled: true}))
+
+	// other writer stores access DB read before our write
+	conn.mu.Lock()
+	conn.overwrite = conn.data
+	conn.mu.Unlock()
+	err = svc.CreateUser(ctx, User{Username: "bob", Password: "secret", Roles: []string{"read-only"}, Enabled: true})
+	r.ErrorIs(err, types.ErrAborted)
+	_, err = svc.GetUser(ctx, "bob")
+	r.ErrorIs(err, types.ErrNotFound, "rolled back to stored state")
+	_, err = svc.GetUser(ctx, "alice")
+	r.NoError(err)
+
+	// retry succeeds
+	r.NoError(svc.CreateUser(ctx, User{Username: "bob", Password: "secret", Roles: []string{"read-only"}, Enabled: true}))
+}
+
+func Test_AccessDB_userExt(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	// extension fields stored in access DB by previous versions
+	conn := &accessDBConn{data: []byte(`{"users":{"ci":{"username":"ci","roles":["read-only"],"password":"","enabled":true,"serviceAccount":true},` +
+		`"bob":{"username":"bob","roles":["read-only"],"password":"x","enabled":true}},"roles":{},"version":2}`)}
+	svc, err := New(rados.NewWithConn(conn), nil, PasswordPolicy{}, bcrypt.MinCost)
+	r.NoError(err)
+	usr, err := svc.GetUser(ctx, "ci")
+	r.NoError(err)
+	r.True(usr.ServiceAccount)
+
+	// extensions are moved to separate config-keys on write
+	_, _, err = svc.CreateAPIKey(ctx, "ci", "k", nil)
+	r.NoError(err)
+	r.NotContains(string(conn.data), "serviceAccount")
+	r.NotContains(string(conn.data), "apiKeys")
+	r.Contains(string(conn.keys[userExtKeyPrefix+"ci"]), `"serviceAccount":true`)
+	r.NotContains(conn.keys, userExtKeyPrefix+"bob", "only users with extensions")
+
+	// Ceph dashboard rewrites access DB without extension fields
+	conn.mu.Lock()
+	conn.data = []byte(`{"users":{"ci":{"username":"ci","roles":["administrator"],"password":"","enabled":true},` +
+		`"bob":{"username":"bob","roles":["read-only"],"password":"x","enabled":true}},"roles":{},"version":2}`)
+	conn.mu.Unlock()
+	r.NoError(svc.reloadDB(ctx))
+	usr, err = svc.GetUser(ctx, "ci")
+	r.NoError(err)
+	r.EqualValues([]string{"administrator"}, usr.Roles)
+	r.True(usr.ServiceAccount)
+	r.Len(usr.APIKeys, 1)
+
+	// extension of user deleted by Ceph dashboard is removed on the next write
+	conn.mu.Lock()
+	conn.data = []byte(`{"users":{"bob":{"username":"bob","roles":["read-only"],"password":"x","enabled":true}},"roles":{},"version":2}`)
+	conn.mu.Unlock()
+	r.NoError(svc.SetEnabled(ctx, "bob", false))
+	_, err = svc.GetUser(ctx, "ci")
+	r.ErrorIs(err, types.ErrNotFound)
+	r.NotContains(conn.keys, userExtKeyPrefix+"ci")
+}
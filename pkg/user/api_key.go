@@ -0,0 +1,258 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// APIKeyPrefix - prefix of API key strings. Used to distinguish API keys from JWT bearer tokens.
+	APIKeyPrefix = "cephapi_"
+	// apiKeyLastUsedInterval - how often key last used time is updated.
+	apiKeyLastUsedInterval = 10 * time.Minute
+	// apiKeyUsageFlushInterval - how often updated last used times of all keys are persisted in access DB.
+	apiKeyUsageFlushInterval = time.Minute
+)
+
+// APIKey - named long-lived credential of service account. Only secret hash is stored.
+type APIKey struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Hash      string `json:"hash"`
+	CreatedAt int64  `json:"createdAt"`
+	ExpiresAt *int64 `json:"expiresAt,omitempty"`
+	LastUsed  *int64 `json:"lastUsed,omitempty"`
+}
+
+func (k *APIKey) expired(now time.Time) bool {
+	return k.ExpiresAt != nil && *k.ExpiresAt <= now.Unix()
+}
+
+// CreateServiceAccount creates user for automation which can authenticate only with API keys.
+func (s *Service) CreateServiceAccount(ctx context.Context, username string, name *string, roles []string) error {
+	s.Lock()
+	defer s.Unlock()
+	if username == "" {
+		return fmt.Errorf("%w: username required", types.ErrInvalidArg)
+	}
+	if _, ok := s.users[username]; ok {
+		return types.ErrAlreadyExists
+	}
+	// password login is not possible for service accounts
+	pwd := make([]byte, 32)
+	if _, err := rand.Read(pwd); err != nil {
+		return err
+	}
+	pwdHash, err := s.hashPassword(hex.EncodeToString(pwd))
+	if err != nil {
+		return err
+	}
+	user := User{
+		Username:       username,
+		Name:           name,
+		Roles:          roles,
+		Password:       pwdHash,
+		Enabled:        true,
+		ServiceAccount: true,
+		LastUpdate:     int(time.Now().Unix()),
+	}
+	if err = s.validateUseRoles(user); err != nil {
+		return err
+	}
+	s.users[username] = user
+	return s.storeToDBOrRollback(ctx)
+}
+
+// CreateAPIKey creates new service account API key and returns key string. Key string cannot be retrieved later.
+func (s *Service) CreateAPIKey(ctx context.Context, username, name string, expiresAt *time.Time) (string, APIKey, error) {
+	s.Lock()
+	defer s.Unlock()
+	user, ok := s.users[username]
+	if !ok {
+		return "", APIKey{}, types.ErrNotFound
+	}
+	if !user.ServiceAccount {
+		return "", APIKey{}, fmt.Errorf("%w: API keys can be created only for service accounts", types.ErrInvalidArg)
+	}
+	if name == "" {
+		return "", APIKey{}, fmt.Errorf("%w: API key name required", types.ErrInvalidArg)
+	}
+	if slices.ContainsFunc(user.APIKeys, func(k APIKey) bool { return k.Name == name }) {
+		return "", APIKey{}, fmt.Errorf("%w: API key %s", types.ErrAlreadyExists, name)
+	}
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return "", APIKey{}, fmt.Errorf("%w: API key expiration time is in the past", types.ErrInvalidArg)
+	}
+	id, secret := make([]byte, 8), make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return "", APIKey{}, err
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return "", APIKey{}, err
+	}
+	key := APIKey{
+		ID:        hex.EncodeToString(id),
+		Name:      name,
+		Hash:      hashAPIKeySecret(hex.EncodeToString(secret)),
+		CreatedAt: now.Unix(),
+	}
+	if expiresAt != nil {
+		exp := expiresAt.Unix()
+		key.ExpiresAt = &exp
+	}
+	user.APIKeys = append(slices.Clone(user.APIKeys), key)
+	user.LastUpdate = int(now.Unix())
+	s.users[username] = user
+	if err := s.storeToDBOrRollback(ctx); err != nil {
+		return "", APIKey{}, err
+	}
+	return APIKeyPrefix + key.ID + "_" + hex.EncodeToString(secret), key, nil
+}
+
+func (s *Service) ListAPIKeys(ctx context.Context, username string) ([]APIKey, error) {
+	s.RLock()
+	defer s.RUnlock()
+	user, ok := s.users[username]
+	if !ok {
+		return nil, types.ErrNotFound
+	}
+	return slices.Clone(user.APIKeys), nil
+}
+
+func (s *Service) RevokeAPIKey(ctx context.Context, username, name string) error {
+	s.Lock()
+	defer s.Unlock()
+	user, ok := s.users[username]
+	if !ok {
+		return types.ErrNotFound
+	}
+	idx := slices.IndexFunc(user.APIKeys, func(k APIKey) bool { return k.Name == name })
+	if idx < 0 {
+		return fmt.Errorf("%w: API key %s", types.ErrNotFound, name)
+	}
+	user.APIKeys = slices.Delete(slices.Clone(user.APIKeys), idx, idx+1)
+	user.LastUpdate = int(time.Now().Unix())
+	s.users[username] = user
+	return s.storeToDBOrRollback(ctx)
+}
+
+// AuthenticateAPIKey returns given valid API key and enabled service account owning it.
+func (s *Service) AuthenticateAPIKey(ctx context.Context, apiKey string) (User, APIKey, error) {
+	id, secret, ok := strings.Cut(strings.TrimPrefix(apiKey, APIKeyPrefix), "_")
+	if !ok || !strings.HasPrefix(apiKey, APIKeyPrefix) {
+		return User{}, APIKey{}, fmt.Errorf("%w: malformed API key", types.ErrUnauthenticated)
+	}
+	hash := hashAPIKeySecret(secret)
+	now := time.Now()
+	s.RLock()
+	user, keyIdx := s.findAPIKey(id)
+	s.RUnlock()
+	if keyIdx < 0 {
+		return User{}, APIKey{}, fmt.Errorf("%w: unknown API key", types.ErrUnauthenticated)
+	}
+	key := user.APIKeys[keyIdx]
+	if subtle.ConstantTimeCompare([]byte(key.Hash), []byte(hash)) != 1 {
+		return User{}, APIKey{}, fmt.Errorf("%w: invalid API key", types.ErrUnauthenticated)
+	}
+	if key.expired(now) {
+		return User{}, APIKey{}, fmt.Errorf("%w: API key %s expired", types.ErrUnauthenticated, key.Name)
+	}
+	if !user.Enabled {
+		return User{}, APIKey{}, fmt.Errorf("%w: user %s is disabled", types.ErrUnauthenticated, user.Username)
+	}
+	if key.LastUsed == nil || now.Sub(time.Unix(*key.LastUsed, 0)) > apiKeyLastUsedInterval {
+		s.usageMu.Lock()
+		if s.apiKeyUsage == nil {
+			s.apiKeyUsage = map[string]int64{}
+		}
+		s.apiKeyUsage[id] = now.Unix()
+		s.usageMu.Unlock()
+	}
+	return user, key, nil
+}
+
+func (s *Service) findAPIKey(id string) (User, int) {
+	for _, user := range s.users {
+		if idx := slices.IndexFunc(user.APIKeys, func(k APIKey) bool { return k.ID == id }); idx >= 0 {
+			return user, idx
+		}
+	}
+	return User{}, -1
+}
+
+// StoreAPIKeyUsage periodically stores last used time of authenticated API keys in access DB with a single write.
+// Blocks until ctx is done, pending usage is stored on exit.
+func (s *Service) StoreAPIKeyUsage(ctx context.Context) error {
+	ticker := time.NewTicker(apiKeyUsageFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+			defer cancel()
+			s.flushAPIKeyUsage(flushCtx)
+			return nil
+		case <-ticker.C:
+			s.flushAPIKeyUsage(ctx)
+		}
+	}
+}
+
+// flushAPIKeyUsage updates last used time of keys used since the previous flush. Errors are only logged,
+// usage is retried on the next flush.
+func (s *Service) flushAPIKeyUsage(ctx context.Context) {
+	s.usageMu.Lock()
+	usage := s.apiKeyUsage
+	s.apiKeyUsage = nil
+	s.usageMu.Unlock()
+	if len(usage) == 0 {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	changed := false
+	for id, lastUsed := range usage {
+		user, idx := s.findAPIKey(id)
+		if idx < 0 {
+			continue
+		}
+		user.APIKeys = slices.Clone(user.APIKeys)
+		lastUsed := lastUsed
+		user.APIKeys[idx].LastUsed = &lastUsed
+		s.users[user.Username] = user
+		changed = true
+	}
+	if !changed {
+		return
+	}
+	if err := s.storeToDBOrRollback(ctx); err != nil {
+		zerolog.Ctx(ctx).Err(err).Int("keys", len(usage)).Msg("unable to update API keys last used time")
+		s.usageMu.Lock()
+		if s.apiKeyUsage == nil {
+			s.apiKeyUsage = map[string]int64{}
+		}
+		for id, lastUsed := range usage {
+			if _, ok := s.apiKeyUsage[id]; !ok {
+				s.apiKeyUsage[id] = lastUsed
+			}
+		}
+		s.usageMu.Unlock()
+	}
+}
+
+func hashAPIKeySecret(secret string) string {
+	// secret is random with high entropy, so fast hash is sufficient
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,146 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func Test_APIKey(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	conn := &accessDBConn{}
+	svc, err := New(rados.NewWithConn(conn), nil, PasswordPolicy{}, bcrypt.MinCost)
+	r.NoError(err)
+	r.NoError(svc.CreateServiceAccount(ctx, "ci", nil, []string{"read-only"}))
+	r.NoError(svc.CreateUser(ctx, User{Username: "alice", Password: "secret", Roles: []string{"read-only"}, Enabled: true}))
+
+	// create
+	_, _, err = svc.CreateAPIKey(ctx, "alice", "k", nil)
+	r.ErrorIs(err, types.ErrInvalidArg, "only service accounts")
+	_, _, err = svc.CreateAPIKey(ctx, "unknown", "k", nil)
+	r.ErrorIs(err, types.ErrNotFound)
+	_, _, err = svc.CreateAPIKey(ctx, "ci", "", nil)
+	r.ErrorIs(err, types.ErrInvalidArg)
+	past := time.Now().Add(-time.Minute)
+	_, _, err = svc.CreateAPIKey(ctx, "ci", "k", &past)
+	r.ErrorIs(err, types.ErrInvalidArg)
+
+	apiKey, key, err := svc.CreateAPIKey(ctx, "ci", "k", nil)
+	r.NoError(err)
+	r.True(strings.HasPrefix(apiKey, APIKeyPrefix+key.ID+"_"))
+	r.NotContains(fmt.Sprint(conn.dump()), strings.TrimPrefix(apiKey, APIKeyPrefix+key.ID+"_"), "only hash is stored")
+	_, _, err = svc.CreateAPIKey(ctx, "ci", "k", nil)
+	r.ErrorIs(err, types.ErrAlreadyExists)
+	future := time.Now().Add(time.Hour)
+	apiKey2, key2, err := svc.CreateAPIKey(ctx, "ci", "k2", &future)
+	r.NoError(err)
+	r.Equal(future.Unix(), *key2.ExpiresAt)
+
+	// list
+	keys, err := svc.ListAPIKeys(ctx, "ci")
+	r.NoError(err)
+	r.Equal([]APIKey{key, key2}, keys)
+
+	// authenticate
+	user, authKey, err := svc.AuthenticateAPIKey(ctx, apiKey)
+	r.NoError(err)
+	r.Equal("ci", user.Username)
+	r.Equal(key.ID, authKey.ID)
+	_, _, err = svc.AuthenticateAPIKey(ctx, apiKey2)
+	r.NoError(err)
+	_, _, err = svc.AuthenticateAPIKey(ctx, apiKey+"0")
+	r.ErrorIs(err, types.ErrUnauthenticated, "wrong secret")
+	_, _, err = svc.AuthenticateAPIKey(ctx, strings.Replace(apiKey, key.ID, key2.ID, 1))
+	r.ErrorIs(err, types.ErrUnauthenticated, "secret of other key")
+	for _, malformed := range []string{"", APIKeyPrefix, APIKeyPrefix + key.ID, strings.TrimPrefix(apiKey, APIKeyPrefix)} {
+		_, _, err = svc.AuthenticateAPIKey(ctx, malformed)
+		r.ErrorIs(err, types.ErrUnauthenticated, malformed)
+	}
+
+	// expiry
+	svc.Lock()
+	ci := svc.users["ci"]
+	expired := time.Now().Unix()
+	ci.APIKeys[1].ExpiresAt = &expired
+	svc.Unlock()
+	_, _, err = svc.AuthenticateAPIKey(ctx, apiKey2)
+	r.ErrorIs(err, types.ErrUnauthenticated)
+
+	// disabled account
+	r.NoError(svc.SetEnabled(ctx, "ci", false))
+	_, _, err = svc.AuthenticateAPIKey(ctx, apiKey)
+	r.ErrorIs(err, types.ErrUnauthenticated)
+	r.NoError(svc.SetEnabled(ctx, "ci", true))
+
+	// revoke
+	r.ErrorIs(svc.RevokeAPIKey(ctx, "ci", "unknown"), types.ErrNotFound)
+	r.NoError(svc.RevokeAPIKey(ctx, "ci", "k"))
+	_, _, err = svc.AuthenticateAPIKey(ctx, apiKey)
+	r.ErrorIs(err, types.ErrUnauthenticated)
+	keys, err = svc.ListAPIKeys(ctx, "ci")
+	r.NoError(err)
+	r.Len(keys, 1)
+	r.Equal("k2", keys[0].Name)
+}
+
+func Test_APIKey_lastUsed(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	conn := &accessDBConn{}
+	svc, err := New(rados.NewWithConn(conn), nil, PasswordPolicy{}, bcrypt.MinCost)
+	r.NoError(err)
+	r.NoError(svc.CreateServiceAccount(ctx, "ci", nil, []string{"read-only"}))
+	apiKey1, _, err := svc.CreateAPIKey(ctx, "ci", "k1", nil)
+	r.NoError(err)
+	apiKey2, _, err := svc.CreateAPIKey(ctx, "ci", "k2", nil)
+	r.NoError(err)
+	stored := conn.dump()
+
+	for i := 0; i < 10; i++ {
+		_, _, err = svc.AuthenticateAPIKey(ctx, apiKey1)
+		r.NoError(err)
+		_, _, err = svc.AuthenticateAPIKey(ctx, apiKey2)
+		r.NoError(err)
+	}
+	r.Equal(stored, conn.dump(), "authentication does not write access DB")
+	keys, err := svc.ListAPIKeys(ctx, "ci")
+	r.NoError(err)
+	r.Nil(keys[0].LastUsed)
+
+	svc.flushAPIKeyUsage(ctx)
+	r.NotEqual(stored, conn.dump(), "usage of both keys stored with single write")
+	keys, err = svc.ListAPIKeys(ctx, "ci")
+	r.NoError(err)
+	r.NotNil(keys[0].LastUsed)
+	r.NotNil(keys[1].LastUsed)
+	r.NotSame(keys[0].LastUsed, keys[1].LastUsed, "last used is not shared between keys")
+
+	// recently used keys are not updated again
+	stored = conn.dump()
+	_, _, err = svc.AuthenticateAPIKey(ctx, apiKey1)
+	r.NoError(err)
+	svc.flushAPIKeyUsage(ctx)
+	r.Equal(stored, conn.dump())
+
+	// pending usage is stored on shutdown
+	lastUsed := time.Now().Add(-time.Hour).Unix()
+	svc.Lock()
+	svc.users["ci"].APIKeys[0].LastUsed = &lastUsed
+	svc.Unlock()
+	_, _, err = svc.AuthenticateAPIKey(ctx, apiKey1)
+	r.NoError(err)
+	loopCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	r.NoError(svc.StoreAPIKeyUsage(loopCtx))
+	keys, err = svc.ListAPIKeys(ctx, "ci")
+	r.NoError(err)
+	r.Greater(*keys[0].LastUsed, lastUsed)
+}
@@ -0,0 +1,263 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/types"
+)
+
+const backupVersion = 1
+
+// Backup - exported access DB signed with HMAC-SHA256.
+// Users and roles are kept raw, so signature is checked against the exact signed content.
+type Backup struct {
+	Version   int             `json:"version"`
+	CreatedAt int64           `json:"createdAt"`
+	Users     json.RawMessage `json:"users"`
+	Roles     json.RawMessage `json:"roles"`
+	// WithoutCredentials - password hashes, API keys and MFA are not exported. Users keep current credentials on import.
+	WithoutCredentials bool `json:"withoutCredentials,omitempty"`
+	// Signature - hex encoded HMAC of version, creation time, credentials flag and compacted users and roles JSON.
+	Signature string `json:"signature,omitempty"`
+}
+
+func (b *Backup) sign(key []byte) (string, error) {
+	var payload bytes.Buffer
+	fmt.Fprintf(&payload, `{"version":%d,"createdAt":%d`, b.Version, b.CreatedAt)
+	if b.WithoutCredentials {
+		payload.WriteString(`,"withoutCredentials":true`)
+	}
+	for _, field := range []struct {
+		name string
+		raw  json.RawMessage
+	}{{"users", b.Users}, {"roles", b.Roles}} {
+		fmt.Fprintf(&payload, `,%q:`, field.name)
+		if len(field.raw) == 0 {
+			payload.WriteString("null")
+			continue
+		}
+		if err := json.Compact(&payload, field.raw); err != nil {
+			return "", fmt.Errorf("%w: invalid backup %s: %v", types.ErrInvalidArg, field.name, err)
+		}
+	}
+	payload.WriteString("}")
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload.Bytes())
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+type ImportMode string
+
+const (
+	// ImportReplace - access DB is replaced with backup content.
+	ImportReplace ImportMode = "replace"
+	// ImportMerge - users and roles from backup are created or overwritten, other users and roles are kept.
+	ImportMerge ImportMode = "merge"
+)
+
+type ImportOpts struct {
+	Mode ImportMode
+	// DryRun - only return changes without applying them.
+	DryRun bool
+	// SkipSignature - accept backup signed with other key, e.g. after OAuth secret is lost.
+	SkipSignature bool
+}
+
+const (
+	ChangeCreate = "create"
+	ChangeUpdate = "update"
+	ChangeDelete = "delete"
+)
+
+// DBChange - difference between current access DB and imported backup.
+type DBChange struct {
+	// Kind - user or role.
+	Kind   string
+	Name   string
+	Action string
+	// Fields - changed fields of updated entry.
+	Fields []string
+}
+
+// ExportDB returns access DB content as signed JSON document.
+// Unless withoutCredentials is set, document contains password hashes and must be stored securely.
+func (s *Service) ExportDB(ctx context.Context, key []byte, withoutCredentials bool) ([]byte, error) {
+	s.RLock()
+	res := Backup{Version: backupVersion, CreatedAt: time.Now().Unix(), WithoutCredentials: withoutCredentials}
+	users := s.users
+	if withoutCredentials {
+		users = make(map[string]User, len(s.users))
+		for name, usr := range s.users {
+			usr.Password, usr.APIKeys, usr.MFA = "", nil, nil
+			users[name] = usr
+		}
+	}
+	var err error
+	if res.Users, err = json.Marshal(users); err == nil {
+		res.Roles, err = json.Marshal(s.roles)
+	}
+	s.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	if res.Signature, err = res.sign(key); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(&res, "", "  ")
+}
+
+// ImportDB validates backup created by ExportDB and applies it to access DB.
+// Replacing access DB with backup which does not contain request user is rejected.
+// Returns changes made or, in dry run mode, changes which would be made.
+func (s *Service) ImportDB(ctx context.Context, data, key []byte, opts ImportOpts) ([]DBChange, error) {
+	var backup Backup
+	if err := json.Unmarshal(data, &backup); err != nil {
+		return nil, fmt.Errorf("%w: invalid backup document: %v", types.ErrInvalidArg, err)
+	}
+	if backup.Version != backupVersion {
+		return nil, fmt.Errorf("%w: unsupported backup version %d", types.ErrInvalidArg, backup.Version)
+	}
+	if !opts.SkipSignature {
+		sig, err := backup.sign(key)
+		if err != nil {
+			return nil, err
+		}
+		if !hmac.Equal([]byte(sig), []byte(backup.Signature)) {
+			return nil, fmt.Errorf("%w: invalid backup signature", types.ErrInvalidArg)
+		}
+	}
+	var backupUsers map[string]User
+	var backupRoles map[string]Role
+	if len(backup.Users) != 0 {
+		if err := json.Unmarshal(backup.Users, &backupUsers); err != nil {
+			return nil, fmt.Errorf("%w: invalid backup users: %v", types.ErrInvalidArg, err)
+		}
+	}
+	if len(backup.Roles) != 0 {
+		if err := json.Unmarshal(backup.Roles, &backupRoles); err != nil {
+			return nil, fmt.Errorf("%w: invalid backup roles: %v", types.ErrInvalidArg, err)
+		}
+	}
+	s.Lock()
+	defer s.Unlock()
+	users, roles := map[string]User{}, map[string]Role{}
+	switch opts.Mode {
+	case ImportReplace:
+	case ImportMerge:
+		for k, v := range s.users {
+			users[k] = v
+		}
+		for k, v := range s.roles {
+			roles[k] = v
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown import mode %q, valid values: %+q", types.ErrInvalidArg, opts.Mode, []ImportMode{ImportReplace, ImportMerge})
+	}
+	for name, role := range backupRoles {
+		if role.Name != name {
+			return nil, fmt.Errorf("%w: role %s has name %q", types.ErrInvalidArg, name, role.Name)
+		}
+		if _, ok := systemRoleMap[name]; ok || role.IsSystem {
+			return nil, fmt.Errorf("%w: role %s: cannot import system role", types.ErrInvalidArg, name)
+		}
+		if err := role.Validate(s.clusters); err != nil {
+			return nil, fmt.Errorf("%w: role %s", err, name)
+		}
+		roles[name] = role
+	}
+	for name, usr := range backupUsers {
+		if usr.Username != name {
+			return nil, fmt.Errorf("%w: user %s has username %q", types.ErrInvalidArg, name, usr.Username)
+		}
+		if backup.WithoutCredentials {
+			current, ok := s.users[name]
+			if !ok {
+				return nil, fmt.Errorf("%w: user %s: backup without credentials cannot create users", types.ErrInvalidArg, name)
+			}
+			usr.Password, usr.APIKeys, usr.MFA = current.Password, current.APIKeys, current.MFA
+		}
+		if err := usr.Validate(); err != nil {
+			return nil, fmt.Errorf("%w: user %s", err, name)
+		}
+		if err := ValidatePasswordHash(usr.Password); err != nil {
+			return nil, fmt.Errorf("%w: user %s", err, name)
+		}
+		users[name] = usr
+	}
+	for name, usr := range users {
+		for _, role := range usr.Roles {
+			_, ok := roles[role]
+			if _, isSystem := systemRoleMap[role]; !ok && !isSystem {
+				return nil, fmt.Errorf("%w: user %s: role %s not found", types.ErrInvalidArg, name, role)
+			}
+		}
+	}
+	// replace must not lock out admin restoring the backup
+	if caller := xctx.GetUsername(ctx); caller != "" {
+		_, exists := s.users[caller]
+		if _, kept := users[caller]; exists && !kept {
+			return nil, fmt.Errorf("%w: backup does not contain user %s making the request", types.ErrInvalidArg, caller)
+		}
+	}
+	changes := append(diffEntries("role", s.roles, roles), diffEntries("user", s.users, users)...)
+	if opts.DryRun || len(changes) == 0 {
+		return changes, nil
+	}
+	s.users, s.roles = users, roles
+	if err := s.storeToDBOrRollback(ctx); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// diffEntries returns changes of users or roles sorted by name.
+func diffEntries[T any](kind string, from, to map[string]T) []DBChange {
+	var res []DBChange
+	for name := range from {
+		if _, ok := to[name]; !ok {
+			res = append(res, DBChange{Kind: kind, Name: name, Action: ChangeDelete})
+		}
+	}
+	for name := range to {
+		if _, ok := from[name]; !ok {
+			res = append(res, DBChange{Kind: kind, Name: name, Action: ChangeCreate})
+			continue
+		}
+		if fields := changedFields(entryJSON(from, name), entryJSON(to, name)); len(fields) != 0 {
+			res = append(res, DBChange{Kind: kind, Name: name, Action: ChangeUpdate, Fields: fields})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
+
+// changedFields returns sorted names of top level JSON fields with different values.
+func changedFields(from, to []byte) []string {
+	var a, b map[string]json.RawMessage
+	_ = json.Unmarshal(from, &a)
+	_ = json.Unmarshal(to, &b)
+	var res []string
+	for k, v := range b {
+		if prev, ok := a[k]; !ok || !bytes.Equal(prev, v) {
+			res = append(res, k)
+		}
+	}
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			res = append(res, k)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
@@ -0,0 +1,125 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func Test_BackupRestore(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	key := []byte("backup-key")
+	svc, err := New(rados.NewWithConn(&accessDBConn{}), nil, PasswordPolicy{}, bcrypt.MinCost)
+	r.NoError(err)
+	r.NoError(svc.CreateRole(ctx, Role{Name: "pool-admin", Permissions: map[string][]string{"pool": {"read", "create"}}}))
+	r.NoError(svc.CreateUser(ctx, User{Username: "alice", Password: "secret", Roles: []string{"pool-admin"}, Enabled: true}))
+	r.NoError(svc.CreateUser(ctx, User{Username: "bob", Password: "secret", Roles: []string{"read-only"}, Enabled: true}))
+	backup, err := svc.ExportDB(ctx, key, false)
+	r.NoError(err)
+
+	// signature
+	_, err = svc.ImportDB(ctx, backup, []byte("other-key"), ImportOpts{Mode: ImportReplace, DryRun: true})
+	r.ErrorIs(err, types.ErrInvalidArg)
+	tampered := bytes.Replace(backup, []byte(`"read-only"`), []byte(`"administrator"`), 1)
+	_, err = svc.ImportDB(ctx, tampered, key, ImportOpts{Mode: ImportReplace, DryRun: true})
+	r.ErrorIs(err, types.ErrInvalidArg)
+	// fields unknown to API are signed too
+	tampered = bytes.Replace(backup, []byte(`"username": "bob",`), []byte(`"username": "bob", "extra": true,`), 1)
+	r.NotEqual(backup, tampered)
+	_, err = svc.ImportDB(ctx, tampered, key, ImportOpts{Mode: ImportReplace, DryRun: true})
+	r.ErrorIs(err, types.ErrInvalidArg)
+	// formatting is not signed
+	reformatted := bytes.ReplaceAll(backup, []byte("\n"), []byte("\n  "))
+	_, err = svc.ImportDB(ctx, reformatted, key, ImportOpts{Mode: ImportReplace, DryRun: true})
+	r.NoError(err)
+	tampered = bytes.Replace(backup, []byte(`"read-only"`), []byte(`"administrator"`), 1)
+	changes, err := svc.ImportDB(ctx, tampered, nil, ImportOpts{Mode: ImportReplace, DryRun: true, SkipSignature: true})
+	r.NoError(err)
+	r.Equal([]DBChange{{Kind: "user", Name: "bob", Action: ChangeUpdate, Fields: []string{"roles"}}}, changes)
+
+	changes, err = svc.ImportDB(ctx, backup, key, ImportOpts{Mode: ImportReplace})
+	r.NoError(err)
+	r.Empty(changes, "nothing changed")
+
+	// changes after backup
+	r.NoError(svc.DeleteUser(ctx, "bob"))
+	r.NoError(svc.CreateUser(ctx, User{Username: "carol", Password: "secret", Roles: []string{"read-only"}, Enabled: true}))
+	r.NoError(svc.SetEnabled(ctx, "alice", false))
+
+	changes, err = svc.ImportDB(ctx, backup, key, ImportOpts{Mode: ImportMerge, DryRun: true})
+	r.NoError(err)
+	r.Len(changes, 2)
+	r.Equal(DBChange{Kind: "user", Name: "alice", Action: ChangeUpdate, Fields: changes[0].Fields}, changes[0])
+	r.Contains(changes[0].Fields, "enabled")
+	r.Equal(DBChange{Kind: "user", Name: "bob", Action: ChangeCreate}, changes[1])
+	changes, err = svc.ImportDB(ctx, backup, key, ImportOpts{Mode: ImportReplace, DryRun: true})
+	r.NoError(err)
+	r.Len(changes, 3)
+	r.Equal(DBChange{Kind: "user", Name: "carol", Action: ChangeDelete}, changes[2])
+	_, err = svc.GetUser(ctx, "bob")
+	r.ErrorIs(err, types.ErrNotFound, "dry run")
+
+	_, err = svc.ImportDB(ctx, backup, key, ImportOpts{Mode: ImportReplace})
+	r.NoError(err)
+	users, err := svc.ListUsers(ctx)
+	r.NoError(err)
+	r.Len(users, 2)
+	r.True(users[0].Enabled)
+	r.NoError(svc.CheckPassword(ctx, "bob", "secret"))
+
+	// validation
+	for _, doc := range []string{
+		`{"version": 1, "roles": {"x": {"name": "x", "scopes_permissions": {"unknown": ["read"]}}}}`,
+		`{"version": 1, "roles": {"x": {"name": "y"}}}`,
+		`{"version": 1, "roles": {"administrator": {"name": "administrator"}}}`,
+		`{"version": 1, "users": {"x": {"username": "x", "password": "$2a$04$invalid"}}}`,
+		`{"version": 1, "users": {"": {"username": ""}}}`,
+		`{"version": 1, "users": {"x": {"username": "x"}}}`,
+		`{"version": 2}`,
+	} {
+		_, err = svc.ImportDB(ctx, []byte(doc), key, ImportOpts{Mode: ImportMerge, SkipSignature: true})
+		r.ErrorIs(err, types.ErrInvalidArg, doc)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	r.NoError(err)
+	doc := `{"version": 1, "users": {"dave": {"username": "dave", "password": "` + string(hash) + `", "roles": ["missing"]}}}`
+	_, err = svc.ImportDB(ctx, []byte(doc), key, ImportOpts{Mode: ImportMerge, SkipSignature: true})
+	r.ErrorIs(err, types.ErrInvalidArg, "role not found")
+	_, err = svc.ImportDB(ctx, []byte(`{"version": 1, "users": {"alice": {"username": "alice", "password": "`+string(hash)+`", "roles": ["pool-admin"]}}}`),
+		key, ImportOpts{Mode: ImportReplace, SkipSignature: true})
+	r.ErrorIs(err, types.ErrInvalidArg, "role is not in backup")
+
+	// backup without credentials keeps current credentials
+	stripped, err := svc.ExportDB(ctx, key, true)
+	r.NoError(err)
+	r.NotContains(string(stripped), "$2")
+	r.NoError(svc.SetEnabled(ctx, "bob", false))
+	tampered = bytes.Replace(stripped, []byte(`"withoutCredentials": true`), []byte(`"withoutCredentials": false`), 1)
+	_, err = svc.ImportDB(ctx, tampered, key, ImportOpts{Mode: ImportMerge, DryRun: true})
+	r.ErrorIs(err, types.ErrInvalidArg)
+	r.ErrorContains(err, "signature", "credentials flag is signed")
+	changes, err = svc.ImportDB(ctx, stripped, key, ImportOpts{Mode: ImportReplace})
+	r.NoError(err)
+	r.Equal([]DBChange{{Kind: "user", Name: "bob", Action: ChangeUpdate, Fields: []string{"enabled"}}}, changes)
+	r.NoError(svc.CheckPassword(ctx, "bob", "secret"))
+	r.NoError(svc.DeleteUser(ctx, "bob"))
+	_, err = svc.ImportDB(ctx, stripped, key, ImportOpts{Mode: ImportMerge, DryRun: true})
+	r.ErrorIs(err, types.ErrInvalidArg, "user cannot be created without credentials")
+
+	// admin cannot remove itself with replace
+	adminCtx := xctx.SetUsername(ctx, "carol")
+	r.NoError(svc.CreateUser(ctx, User{Username: "carol", Password: "secret", Roles: []string{"administrator"}, Enabled: true}))
+	_, err = svc.ImportDB(adminCtx, backup, key, ImportOpts{Mode: ImportReplace, DryRun: true})
+	r.ErrorIs(err, types.ErrInvalidArg)
+	_, err = svc.ImportDB(adminCtx, backup, key, ImportOpts{Mode: ImportMerge})
+	r.NoError(err)
+	_, err = svc.GetUser(ctx, "carol")
+	r.NoError(err)
+}
@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clyso/ceph-api/pkg/types"
+)
+
+// MFA - TOTP second factor of user.
+type MFA struct {
+	// Secret - TOTP secret encrypted by auth package.
+	Secret string `json:"secret"`
+	// Enabled - false until enrollment is confirmed with valid one-time password.
+	Enabled bool `json:"enabled"`
+	// RecoveryCodes - sha256 hashes of unused recovery codes.
+	RecoveryCodes []string `json:"recoveryCodes,omitempty"`
+	// LastUsedStep - TOTP time step of the last accepted code. Used to reject code replay.
+	LastUsedStep int64 `json:"lastUsedStep,omitempty"`
+	CreatedAt    int64 `json:"createdAt"`
+}
+
+// MFAEnabled returns true if user has confirmed TOTP enrollment.
+func (u *User) MFAEnabled() bool {
+	return u.MFA != nil && u.MFA.Enabled
+}
+
+// UpdateMFA atomically changes user MFA settings. Function fn gets copy of current settings
+// (nil if not enrolled) and returns new settings or nil to disable MFA.
+// Settings are not changed if fn returns error.
+func (s *Service) UpdateMFA(ctx context.Context, username string, fn func(mfa *MFA) (*MFA, error)) error {
+	s.Lock()
+	defer s.Unlock()
+	usr, ok := s.users[username]
+	if !ok {
+		return fmt.Errorf("%w: user %s", types.ErrNotFound, username)
+	}
+	var cur *MFA
+	if usr.MFA != nil {
+		cp := *usr.MFA
+		cp.RecoveryCodes = append([]string(nil), usr.MFA.RecoveryCodes...)
+		cur = &cp
+	}
+	next, err := fn(cur)
+	if err != nil {
+		return err
+	}
+	usr.MFA = next
+	s.users[username] = usr
+	return s.storeToDBOrRollback(ctx)
+}
+
+// ResetMFA disables MFA of user, e.g. if user lost TOTP device and recovery codes.
+func (s *Service) ResetMFA(ctx context.Context, username string) error {
+	return s.UpdateMFA(ctx, username, func(*MFA) (*MFA, error) {
+		return nil, nil
+	})
+}
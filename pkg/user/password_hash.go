@@ -0,0 +1,147 @@
+package user
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/rs/zerolog"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// argon2MaxMemory - max memory in KiB (64 MiB) of imported argon2id hashes, limits CPU and memory used by login.
+	argon2MaxMemory = 64 * 1024
+	argon2MaxTime   = 10
+	// argon2MaxConcurrent - max number of argon2id hashes computed at the same time.
+	argon2MaxConcurrent = 4
+)
+
+// argon2Sem bounds memory used by concurrent logins of users with imported argon2id hashes.
+var argon2Sem = make(chan struct{}, argon2MaxConcurrent)
+
+// argon2Params - parameters of argon2id hash in PHC string format:
+// $argon2id$v=19$m=65536,t=3,p=4$<base64 salt>$<base64 key>
+type argon2Params struct {
+	memory, time uint32
+	threads      uint8
+	salt, key    []byte
+}
+
+func parseArgon2id(hash string) (argon2Params, error) {
+	var res argon2Params
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return res, fmt.Errorf("%w: invalid argon2id hash format", types.ErrInvalidArg)
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return res, fmt.Errorf("%w: unsupported argon2id version %q", types.ErrInvalidArg, parts[2])
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &res.memory, &res.time, &res.threads); err != nil {
+		return res, fmt.Errorf("%w: invalid argon2id params %q", types.ErrInvalidArg, parts[3])
+	}
+	if res.time == 0 || res.time > argon2MaxTime || res.threads == 0 || res.memory < 8*uint32(res.threads) || res.memory > argon2MaxMemory {
+		return res, fmt.Errorf("%w: argon2id params %q out of range", types.ErrInvalidArg, parts[3])
+	}
+	var err error
+	if res.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil || len(res.salt) < 8 {
+		return res, fmt.Errorf("%w: invalid argon2id salt", types.ErrInvalidArg)
+	}
+	if res.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(res.key) < 16 {
+		return res, fmt.Errorf("%w: invalid argon2id key", types.ErrInvalidArg)
+	}
+	return res, nil
+}
+
+// ValidatePasswordHash checks that hash imported from other system uses supported scheme: bcrypt or argon2id.
+func ValidatePasswordHash(hash string) error {
+	if strings.HasPrefix(hash, "$argon2id$") {
+		_, err := parseArgon2id(hash)
+		return err
+	}
+	if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+		return fmt.Errorf("%w: unsupported password hash, only bcrypt and argon2id are supported", types.ErrInvalidArg)
+	}
+	return nil
+}
+
+// comparePassword checks password against stored hash.
+// Returns true in rehash if hash is valid, but weaker than bcrypt with given cost.
+func comparePassword(hash, password string, bcryptCost int) (rehash bool, err error) {
+	if strings.HasPrefix(hash, "$argon2id$") {
+		params, err := parseArgon2id(hash)
+		if err != nil {
+			return false, err
+		}
+		argon2Sem <- struct{}{}
+		key := argon2.IDKey([]byte(password), params.salt, params.time, params.memory, params.threads, uint32(len(params.key)))
+		<-argon2Sem
+		if subtle.ConstantTimeCompare(key, params.key) != 1 {
+			return false, bcrypt.ErrMismatchedHashAndPassword
+		}
+		return true, nil
+	}
+	if err = bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+		return false, err
+	}
+	cost, err := bcrypt.Cost([]byte(hash))
+	return cost < bcryptCost, err
+}
+
+func (s *Service) hashPassword(password string) (string, error) {
+	res, err := bcrypt.GenerateFromPassword([]byte(password), s.bcryptCost)
+	return string(res), err
+}
+
+// CheckPassword verifies user password. Password hash with weaker scheme or lower bcrypt cost
+// than configured is replaced with new bcrypt hash after successful check.
+func (s *Service) CheckPassword(ctx context.Context, username, password string) error {
+	s.RLock()
+	usr, ok := s.users[username]
+	s.RUnlock()
+	if !ok {
+		return fmt.Errorf("%w: user not found", types.ErrUnauthenticated)
+	}
+	rehash, err := comparePassword(usr.Password, password, s.bcryptCost)
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return fmt.Errorf("%w: invalid credentials", types.ErrUnauthenticated)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: unable to check password hash: %v", types.ErrUnauthenticated, err)
+	}
+	if rehash {
+		// login must not fail if access DB is not available for writes
+		if err = s.rehashPassword(ctx, username, usr.Password, password); err != nil {
+			zerolog.Ctx(ctx).Err(err).Str("username", username).Msg("unable to rehash user password")
+		}
+	}
+	return nil
+}
+
+func (s *Service) rehashPassword(ctx context.Context, username, oldHash, password string) error {
+	pwdHash, err := s.hashPassword(password)
+	if err != nil {
+		return err
+	}
+	s.Lock()
+	defer s.Unlock()
+	usr, ok := s.users[username]
+	// password was changed during check
+	if !ok || usr.Password != oldHash {
+		return nil
+	}
+	// lastUpdate is not changed: it invalidates dashboard sessions of the user
+	usr.Password = pwdHash
+	s.users[username] = usr
+	if err = s.storeToDBOrRollback(ctx); err != nil {
+		return err
+	}
+	zerolog.Ctx(ctx).Info().Str("username", username).Msg("user password rehashed")
+	return nil
+}
@@ -0,0 +1,56 @@
+package user
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func argon2idHash(password string) string {
+	salt := []byte("0123456789abcdef")
+	key := argon2.IDKey([]byte(password), salt, 2, 64, 1, 32)
+	return fmt.Sprintf("$argon2id$v=%d$m=64,t=2,p=1$%s$%s", argon2.Version,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+}
+
+func Test_comparePassword(t *testing.T) {
+	r := require.New(t)
+	hash := argon2idHash("secret")
+	r.NoError(ValidatePasswordHash(hash))
+	rehash, err := comparePassword(hash, "secret", bcrypt.MinCost)
+	r.NoError(err)
+	r.True(rehash, "argon2id is always rehashed")
+	_, err = comparePassword(hash, "wrong", bcrypt.MinCost)
+	r.ErrorIs(err, bcrypt.ErrMismatchedHashAndPassword)
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost+1)
+	r.NoError(err)
+	r.NoError(ValidatePasswordHash(string(bcryptHash)))
+	rehash, err = comparePassword(string(bcryptHash), "secret", bcrypt.MinCost+1)
+	r.NoError(err)
+	r.False(rehash)
+	rehash, err = comparePassword(string(bcryptHash), "secret", bcrypt.MinCost+2)
+	r.NoError(err)
+	r.True(rehash, "lower bcrypt cost")
+	rehash, err = comparePassword(string(bcryptHash), "secret", bcrypt.MinCost)
+	r.NoError(err)
+	r.False(rehash, "higher cost is not downgraded")
+
+	for _, invalid := range []string{
+		"",
+		"secret",
+		"$argon2i$v=19$m=64,t=2,p=1$MDEyMzQ1Njc4OWFiY2RlZg$MDEyMzQ1Njc4OWFiY2RlZg",
+		"$argon2id$v=16$m=64,t=2,p=1$MDEyMzQ1Njc4OWFiY2RlZg$MDEyMzQ1Njc4OWFiY2RlZg",
+		"$argon2id$v=19$m=4194304,t=2,p=1$MDEyMzQ1Njc4OWFiY2RlZg$MDEyMzQ1Njc4OWFiY2RlZg",
+		"$argon2id$v=19$m=131072,t=2,p=1$MDEyMzQ1Njc4OWFiY2RlZg$MDEyMzQ1Njc4OWFiY2RlZg",
+		"$argon2id$v=19$m=64,t=11,p=1$MDEyMzQ1Njc4OWFiY2RlZg$MDEyMzQ1Njc4OWFiY2RlZg",
+		"$argon2id$v=19$m=64,t=2,p=1$MDEyMzQ1Njc4OWFiY2RlZg$short",
+	} {
+		r.ErrorIs(ValidatePasswordHash(invalid), types.ErrInvalidArg, invalid)
+	}
+}
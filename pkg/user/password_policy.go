@@ -0,0 +1,159 @@
+package user
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/types"
+)
+
+//go:embed common_passwords.txt
+var commonPasswordsFile string
+
+var commonPasswords = func() map[string]struct{} {
+	res := map[string]struct{}{}
+	for _, pwd := range strings.Fields(commonPasswordsFile) {
+		res[strings.ToLower(pwd)] = struct{}{}
+	}
+	return res
+}()
+
+// PasswordPolicy - password checks compatible with Ceph dashboard PWD_POLICY settings.
+type PasswordPolicy struct {
+	Enabled bool `yaml:"enabled"`
+	// MinLength - min password length. 0 - disabled.
+	MinLength int `yaml:"minLength"`
+	// MinComplexity - min sum of password character credits, see PasswordComplexity. 0 - disabled.
+	MinComplexity int `yaml:"minComplexity"`
+	// CheckOldPassword - new password must differ from the current one.
+	CheckOldPassword bool `yaml:"checkOldPassword"`
+	// CheckUsername - password must not contain username.
+	CheckUsername bool `yaml:"checkUsername"`
+	// CheckRepetitiveChars - password must not contain 3 same characters in a row.
+	CheckRepetitiveChars bool `yaml:"checkRepetitiveChars"`
+	// CheckSequentialChars - password must not contain 3 sequential characters like "abc" or "123".
+	CheckSequentialChars bool `yaml:"checkSequentialChars"`
+	// CheckCommonPasswords - password must not be one of well-known common passwords.
+	CheckCommonPasswords bool `yaml:"checkCommonPasswords"`
+	// ExclusionList - case-insensitive words password must not contain.
+	ExclusionList []string `yaml:"exclusionList"`
+	// ExpirationSpan - expiration date of new password is set to now + span, if not set explicitly.
+	// Applied even if policy checks are disabled. 0 - passwords do not expire.
+	ExpirationSpan time.Duration `yaml:"expirationSpan"`
+}
+
+// expirationDate returns expiration date of password set at given time.
+func (p PasswordPolicy) expirationDate(now time.Time) *int {
+	if p.ExpirationSpan <= 0 {
+		return nil
+	}
+	res := int(now.Add(p.ExpirationSpan).Unix())
+	return &res
+}
+
+// Password policy rules returned in PasswordViolation.
+const (
+	PwdRuleLength          = "length"
+	PwdRuleComplexity      = "complexity"
+	PwdRuleOldPassword     = "old_password"
+	PwdRuleUsername        = "username"
+	PwdRuleExclusionList   = "exclusion_list"
+	PwdRuleCommonPassword  = "common_password"
+	PwdRuleRepetitiveChars = "repetitive_chars"
+	PwdRuleSequentialChars = "sequential_chars"
+)
+
+type PasswordViolation struct {
+	Rule    string
+	Message string
+}
+
+// PasswordComplexity returns password complexity credits as calculated by Ceph dashboard:
+// digit and lowercase letter - 1, uppercase letter - 2, special character - 3, other character - 5.
+func PasswordComplexity(password string) int {
+	res := 0
+	for _, ch := range password {
+		switch {
+		case ch >= 'A' && ch <= 'Z':
+			res += 2
+		case ch >= 'a' && ch <= 'z', ch >= '0' && ch <= '9':
+			res++
+		case ch < 128 && strings.ContainsRune("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", ch):
+			res += 3
+		default:
+			res += 5
+		}
+	}
+	return res
+}
+
+// Check returns violated rules. oldPassword is current plaintext password, empty if unknown.
+func (p *PasswordPolicy) Check(username, password, oldPassword string) []PasswordViolation {
+	if !p.Enabled {
+		return nil
+	}
+	var res []PasswordViolation
+	add := func(rule, msg string, args ...any) {
+		res = append(res, PasswordViolation{Rule: rule, Message: fmt.Sprintf(msg, args...)})
+	}
+	lower := strings.ToLower(password)
+	if p.MinLength > 0 && len([]rune(password)) < p.MinLength {
+		add(PwdRuleLength, "password must be at least %d characters long", p.MinLength)
+	}
+	if p.MinComplexity > 0 && PasswordComplexity(password) < p.MinComplexity {
+		add(PwdRuleComplexity, "password is too weak, use more uppercase letters, digits and special characters")
+	}
+	if p.CheckOldPassword && oldPassword != "" && password == oldPassword {
+		add(PwdRuleOldPassword, "password must not be the same as the previous one")
+	}
+	if p.CheckUsername && username != "" && strings.Contains(lower, strings.ToLower(username)) {
+		add(PwdRuleUsername, "password must not contain username")
+	}
+	for _, word := range p.ExclusionList {
+		if word != "" && strings.Contains(lower, strings.ToLower(word)) {
+			add(PwdRuleExclusionList, "password must not contain the keyword %q", word)
+			break
+		}
+	}
+	if _, ok := commonPasswords[lower]; p.CheckCommonPasswords && ok {
+		add(PwdRuleCommonPassword, "password is too common")
+	}
+	chars := []rune(password)
+	if p.CheckRepetitiveChars {
+		for i := 2; i < len(chars); i++ {
+			if chars[i-2] == chars[i-1] && chars[i-1] == chars[i] {
+				add(PwdRuleRepetitiveChars, "password must not contain repetitive characters")
+				break
+			}
+		}
+	}
+	if p.CheckSequentialChars {
+		for i := 2; i < len(chars); i++ {
+			if chars[i-2]+1 == chars[i-1] && chars[i-1]+1 == chars[i] {
+				add(PwdRuleSequentialChars, "password must not contain sequential characters")
+				break
+			}
+		}
+	}
+	return res
+}
+
+// check returns types.ErrInvalidArg with all violated rules.
+func (p *PasswordPolicy) check(username, password, oldPassword string) error {
+	violations := p.Check(username, password, oldPassword)
+	if len(violations) == 0 {
+		return nil
+	}
+	msgs := make([]string, len(violations))
+	for i, v := range violations {
+		msgs[i] = v.Message
+	}
+	return fmt.Errorf("%w: %s", types.ErrInvalidArg, strings.Join(msgs, "; "))
+}
+
+// ValidatePassword checks candidate password against password policy.
+func (s *Service) ValidatePassword(username, password, oldPassword string) []PasswordViolation {
+	return s.pwdPolicy.Check(username, password, oldPassword)
+}
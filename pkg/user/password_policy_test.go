@@ -0,0 +1,99 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func Test_PasswordComplexity(t *testing.T) {
+	require.EqualValues(t, 0, PasswordComplexity(""))
+	require.EqualValues(t, 3, PasswordComplexity("ab1"))
+	require.EqualValues(t, 2+1+3, PasswordComplexity("Ab!"))
+	require.EqualValues(t, 5, PasswordComplexity("ä"))
+}
+
+func Test_PasswordPolicy_Check(t *testing.T) {
+	policy := PasswordPolicy{
+		Enabled:              true,
+		MinLength:            8,
+		MinComplexity:        10,
+		CheckOldPassword:     true,
+		CheckUsername:        true,
+		CheckRepetitiveChars: true,
+		CheckSequentialChars: true,
+		CheckCommonPasswords: true,
+		ExclusionList:        []string{"ceph", "pool"},
+	}
+	tests := []struct {
+		name        string
+		username    string
+		password    string
+		oldPassword string
+		want        []string
+	}{
+		{name: "valid", username: "alice", password: "Tr0ub4dor&3", oldPassword: "x"},
+		{name: "short", password: "Ab1!", want: []string{PwdRuleLength, PwdRuleComplexity}},
+		{name: "weak", password: "zxcvmnbz", want: []string{PwdRuleComplexity}},
+		{name: "old", password: "Tr0ub4dor&3", oldPassword: "Tr0ub4dor&3", want: []string{PwdRuleOldPassword}},
+		{name: "username", username: "Alice", password: "xx-ALICE-2024!", want: []string{PwdRuleUsername}},
+		{name: "exclusion", password: "My-CephPwd!9", want: []string{PwdRuleExclusionList}},
+		{name: "common", password: "Password123", want: []string{PwdRuleCommonPassword, PwdRuleSequentialChars}},
+		{name: "repetitive", password: "Baaad-pwd!7", want: []string{PwdRuleRepetitiveChars}},
+		{name: "sequential", password: "Pxyz-pwd!7", want: []string{PwdRuleSequentialChars}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, v := range policy.Check(tt.username, tt.password, tt.oldPassword) {
+				require.NotEmpty(t, v.Message)
+				got = append(got, v.Rule)
+			}
+			require.ElementsMatch(t, tt.want, got)
+		})
+	}
+
+	policy.Enabled = false
+	require.Empty(t, policy.Check("", "1", ""))
+}
+
+func Test_PasswordExpirationSpan(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	span := 24 * time.Hour
+	svc, err := New(rados.NewWithConn(&accessDBConn{}), nil, PasswordPolicy{ExpirationSpan: span}, bcrypt.MinCost)
+	r.NoError(err)
+	expiresIn := func() time.Duration {
+		usr, err := svc.GetUser(ctx, "alice")
+		r.NoError(err)
+		if usr.PwdExpirationDate == nil {
+			return 0
+		}
+		return time.Until(time.Unix(int64(*usr.PwdExpirationDate), 0))
+	}
+
+	r.NoError(svc.CreateUser(ctx, User{Username: "alice", Password: "secret", Roles: []string{"read-only"}, Enabled: true}))
+	r.InDelta(span, expiresIn(), float64(time.Minute))
+
+	// expired password is changed
+	expired := int(time.Now().Add(-time.Hour).Unix())
+	r.NoError(svc.UpdateUser(ctx, User{Username: "alice", Roles: []string{"read-only"}, Enabled: true, PwdExpirationDate: &expired}, false))
+	r.Negative(expiresIn())
+	r.NoError(svc.ChangePassword(ctx, "alice", "secret", "secret2"))
+	r.InDelta(span, expiresIn(), float64(time.Minute))
+
+	// explicit date is kept, clear removes it
+	later := int(time.Now().Add(48 * time.Hour).Unix())
+	r.NoError(svc.UpdateUser(ctx, User{Username: "alice", Password: "secret3", Roles: []string{"read-only"}, Enabled: true, PwdExpirationDate: &later}, false))
+	r.InDelta(48*time.Hour, expiresIn(), float64(time.Minute))
+	r.NoError(svc.UpdateUser(ctx, User{Username: "alice", Roles: []string{"read-only"}, Enabled: true}, false))
+	r.InDelta(48*time.Hour, expiresIn(), float64(time.Minute), "kept if not set")
+	r.ErrorIs(svc.UpdateUser(ctx, User{Username: "alice", Roles: []string{"read-only"}, Enabled: true, PwdExpirationDate: &later}, true), types.ErrInvalidArg)
+	r.NoError(svc.UpdateUser(ctx, User{Username: "alice", Roles: []string{"read-only"}, Enabled: true}, true))
+	r.Zero(expiresIn())
+}
@@ -0,0 +1,83 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HasResourcePermissions(t *testing.T) {
+	r := require.New(t)
+	ctx := xctx.SetPermissions(context.Background(), map[string][]string{
+		"pool:tenant-a-*":      {"read", "update"},
+		"pool@east:tenant-b-*": {"read"},
+		"cephfs:fs1":           {"read"},
+		"osd":                  {"read"},
+	})
+
+	r.NoError(HasResourcePermissions(ctx, ScopePool, "tenant-a-data", PermRead, PermUpdate))
+	r.ErrorIs(HasResourcePermissions(ctx, ScopePool, "tenant-a-data", PermDelete), types.ErrAccessDenied)
+	r.ErrorIs(HasResourcePermissions(ctx, ScopePool, "tenant-b-data", PermRead), types.ErrAccessDenied)
+	r.NoError(HasResourcePermissions(xctx.SetCluster(ctx, "east"), ScopePool, "tenant-b-data", PermRead))
+	r.NoError(HasResourcePermissions(ctx, ScopeCephfs, "fs1", PermRead))
+	r.ErrorIs(HasResourcePermissions(ctx, ScopeCephfs, "fs10", PermRead), types.ErrAccessDenied)
+	r.NoError(HasResourcePermissions(ctx, ScopeOsd, "any", PermRead), "scope permission grants all resources")
+
+	// resource permissions do not grant scope permissions
+	r.ErrorIs(HasPermissions(ctx, ScopePool, PermRead), types.ErrAccessDenied)
+	r.NoError(HasAnyResourcePermissions(ctx, ScopePool, PermRead))
+	r.ErrorIs(HasAnyResourcePermissions(ctx, ScopePool, PermCreate), types.ErrAccessDenied)
+	r.ErrorIs(HasAnyResourcePermissions(context.Background(), ScopePool, PermRead), types.ErrAccessDenied)
+
+	for scope, valid := range map[string]bool{
+		ResourceScope(string(ScopePool), "tenant-a-*"):                 true,
+		ResourceScope(ClusterScope(ScopeRgw, "east"), "bucket-[0-9]*"): true,
+		ResourceScope(string(ScopePool), ""):                           false,
+		ResourceScope(string(ScopePool), "[a-"):                        false,
+		ResourceScope("unknown", "x"):                                  false,
+		ResourceScope(ClusterScope(ScopePool, ""), "tenant-a-*"):       false,
+		ClusterScope(ScopePool, "default"):                             true,
+		ClusterScope(ScopePool, "west"):                                false,
+		ResourceScope(ClusterScope(ScopePool, "west"), "tenant-a-*"):   false,
+	} {
+		role := Role{Name: "tenant", Permissions: map[string][]string{scope: {"read"}}}
+		if valid {
+			r.NoError(role.Validate([]string{"default", "east"}), scope)
+		} else {
+			r.ErrorIs(role.Validate([]string{"default", "east"}), types.ErrInvalidArg, scope)
+		}
+	}
+	// error refers to the whole scope
+	role := Role{Name: "tenant", Permissions: map[string][]string{"pool@west:tenant-a-*": {"read"}}}
+	r.ErrorContains(role.Validate([]string{"default"}), `unknown cluster "west" in scope pool@west:tenant-a-*`)
+	role = Role{Name: "tenant", Permissions: map[string][]string{"pool:[a-": {"read"}}}
+	r.ErrorContains(role.Validate([]string{"default"}), `invalid resource pattern "[a-" in scope pool:[a-`)
+}
+
+func Test_CheckAccess(t *testing.T) {
+	r := require.New(t)
+	ctx := xctx.SetPermissions(context.Background(), map[string][]string{
+		"pool:tenant-a-*": {"read"},
+		"osd":             {"read"},
+	})
+	allowed, err := CheckAccess(ctx, "osd", "read", "")
+	r.NoError(err)
+	r.True(allowed)
+	allowed, err = CheckAccess(ctx, "osd", "delete", "")
+	r.NoError(err)
+	r.False(allowed)
+	allowed, err = CheckAccess(ctx, "pool", "read", "")
+	r.NoError(err)
+	r.False(allowed)
+	allowed, err = CheckAccess(ctx, "pool", "read", "tenant-a-1")
+	r.NoError(err)
+	r.True(allowed)
+
+	_, err = CheckAccess(ctx, "unknown", "read", "")
+	r.ErrorIs(err, types.ErrInvalidArg)
+	_, err = CheckAccess(ctx, "pool", "write", "")
+	r.ErrorIs(err, types.ErrInvalidArg)
+}
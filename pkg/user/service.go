@@ -1,16 +1,19 @@
 package user
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"path"
 	"slices"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
-	goceph "github.com/ceph/go-ceph/rados"
 	xctx "github.com/clyso/ceph-api/pkg/ctx"
 	"github.com/clyso/ceph-api/pkg/rados"
 	"github.com/clyso/ceph-api/pkg/types"
@@ -19,26 +22,125 @@ import (
 )
 
 const (
-	pwdBcryptCost = 3
-	setDBMonCmd   = `{"prefix": "config-key set", "key": "mgr/dashboard/accessdb_v2"}`
-	getDBMonCmd   = `{"prefix": "config-key get", "key": "mgr/dashboard/accessdb_v2"}`
+	setDBMonCmd = `{"prefix": "config-key set", "key": "mgr/dashboard/accessdb_v2"}`
+	getDBMonCmd = `{"prefix": "config-key get", "key": "mgr/dashboard/accessdb_v2"}`
 )
 
+// HasPermissions checks that request user has given permissions for scope.
+// Scope permissions can be granted for all clusters ("pool") or for cluster selected in request ("pool@<cluster>").
 func HasPermissions(ctx context.Context, scope Scope, perms ...Permission) error {
 	permissions := xctx.GetPermissions(ctx)
 	if len(permissions) == 0 {
 		return types.ErrAccessDenied
 	}
+	clusterScope := ClusterScope(scope, requestCluster(ctx))
 	for _, p := range perms {
-		if !slices.Contains(permissions[string(scope)], p.String()) {
+		if !slices.Contains(permissions[string(scope)], p.String()) && !slices.Contains(permissions[clusterScope], p.String()) {
 			return types.ErrAccessDenied
 		}
 	}
 	return nil
 }
 
-func New(radosSvc *rados.Svc) (*Service, error) {
-	res := &Service{radosSvc: radosSvc}
+// HasResourcePermissions checks that request user has given permissions for named resource of scope, e.g. pool name.
+// Besides the whole scope, permissions can be granted for resources matching name pattern:
+// "pool:tenant-a-*" or "pool@<cluster>:tenant-a-*". Pattern syntax is the same as in path.Match.
+func HasResourcePermissions(ctx context.Context, scope Scope, resource string, perms ...Permission) error {
+	permissions := xctx.GetPermissions(ctx)
+	scopes := []string{string(scope), ClusterScope(scope, requestCluster(ctx))}
+	for _, p := range perms {
+		if !hasResourcePermission(permissions, scopes, p.String(), func(pattern string) bool {
+			ok, _ := path.Match(pattern, resource)
+			return ok
+		}) {
+			return types.ErrAccessDenied
+		}
+	}
+	return nil
+}
+
+// HasAnyResourcePermissions checks that request user has given permissions for scope or at least for some of its resources.
+// List handlers use it before filtering resources with HasResourcePermissions.
+func HasAnyResourcePermissions(ctx context.Context, scope Scope, perms ...Permission) error {
+	permissions := xctx.GetPermissions(ctx)
+	scopes := []string{string(scope), ClusterScope(scope, requestCluster(ctx))}
+	for _, p := range perms {
+		if !hasResourcePermission(permissions, scopes, p.String(), func(string) bool { return true }) {
+			return types.ErrAccessDenied
+		}
+	}
+	return nil
+}
+
+// CheckAccess reports whether request user has permission for scope or, if resource is set, for scope resource.
+func CheckAccess(ctx context.Context, scope, permission, resource string) (bool, error) {
+	s, perms, err := ParseScopePermissions(scope, permission)
+	if err != nil {
+		return false, err
+	}
+	if resource == "" {
+		return HasPermissions(ctx, s, perms...) == nil, nil
+	}
+	return HasResourcePermissions(ctx, s, resource, perms...) == nil, nil
+}
+
+// ParseScopePermissions validates scope and permission names, e.g. "pool" and "read".
+func ParseScopePermissions(scope string, permissions ...string) (Scope, []Permission, error) {
+	if _, ok := scopeSet[scope]; !ok {
+		return "", nil, fmt.Errorf("%w: unknown scope %s", types.ErrInvalidArg, scope)
+	}
+	res := make([]Permission, 0, len(permissions))
+	for _, p := range permissions {
+		perm, ok := permissionSet[p]
+		if !ok {
+			return "", nil, fmt.Errorf("%w: unknown permission %q, valid values %+q", types.ErrInvalidArg, p, permissionList)
+		}
+		res = append(res, perm)
+	}
+	return Scope(scope), res, nil
+}
+
+func hasResourcePermission(permissions map[string][]string, scopes []string, perm string, match func(pattern string) bool) bool {
+	for key, granted := range permissions {
+		if !slices.Contains(granted, perm) {
+			continue
+		}
+		scope, pattern, isResourceScope := strings.Cut(key, ":")
+		if slices.Contains(scopes, scope) && (!isResourceScope || match(pattern)) {
+			return true
+		}
+	}
+	return false
+}
+
+func requestCluster(ctx context.Context) string {
+	if cluster := xctx.GetCluster(ctx); cluster != "" {
+		return cluster
+	}
+	return rados.DefaultCluster
+}
+
+// ClusterScope returns scope name granting permissions only for given cluster.
+func ClusterScope(scope Scope, cluster string) string {
+	return string(scope) + "@" + cluster
+}
+
+// ResourceScope returns scope name granting permissions only for resources matching pattern.
+// Scope can be restricted to cluster with ClusterScope.
+func ResourceScope(scope, pattern string) string {
+	return scope + ":" + pattern
+}
+
+// New creates user service storing access DB in given cluster. Clusters are names of all managed clusters
+// which can be used in role scopes, default cluster is always included.
+func New(radosSvc *rados.Svc, clusters []string, pwdPolicy PasswordPolicy, bcryptCost int) (*Service, error) {
+	if bcryptCost < bcrypt.MinCost || bcryptCost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("%w: bcrypt cost must be between %d and %d", types.ErrInvalidArg, bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	if !slices.Contains(clusters, rados.DefaultCluster) {
+		clusters = append([]string{rados.DefaultCluster}, clusters...)
+	}
+	res := &Service{radosSvc: radosSvc, clusters: clusters, pwdPolicy: pwdPolicy, bcryptCost: bcryptCost}
 	if err := res.updateFromDB(context.Background()); err != nil {
 		return nil, err
 	}
@@ -47,40 +149,89 @@ func New(radosSvc *rados.Svc) (*Service, error) {
 
 type Service struct {
 	sync.RWMutex
-	radosSvc *rados.Svc
-	users    map[string]User
-	roles    map[string]Role
+	radosSvc   *rados.Svc
+	clusters   []string
+	pwdPolicy  PasswordPolicy
+	bcryptCost int
+	users      map[string]User
+	roles      map[string]Role
+	// stored - access DB content which in-memory state is based on.
+	stored storedDB
+
+	usageMu sync.Mutex
+	// apiKeyUsage - last used time of API keys by key ID, not stored in access DB yet.
+	apiKeyUsage map[string]int64
 }
 
+// updateFromDB replaces in-memory state with access DB content.
 func (s *Service) updateFromDB(ctx context.Context) error {
-	s.users = map[string]User{}
-	s.roles = map[string]Role{}
-	cmdRes, err := s.radosSvc.ExecMon(ctx, getDBMonCmd)
+	stored, err := s.readDB(ctx)
+	if err != nil {
+		return err
+	}
+	return s.applyDB(stored)
+}
+
+// storeToDB writes in-memory state to access DB. Changes made by other API replicas or Ceph dashboard
+// since the last read are merged with ours. Conflicting changes of the same user or role are rejected with types.ErrAborted.
+//
+// Config-key store has no compare-and-swap, so read, merge and write are not atomic across replicas.
+// Access DB is read back after the write and types.ErrAborted is returned if it was overwritten meanwhile,
+// but a concurrent writer which read access DB before our write and writes it after our check
+// still silently discards our change.
+//
+// User extensions are written to their own config-keys after access DB, only if changed.
+func (s *Service) storeToDB(ctx context.Context) error {
+	remote, err := s.readDB(ctx)
 	if err != nil {
-		if errors.Is(err, goceph.ErrNotFound) {
-			return nil
-		}
 		return err
 	}
-	var res db
-	if err = json.Unmarshal(cmdRes, &res); err != nil {
+	if !remote.equal(s.stored) {
+		if err = s.mergeDB(ctx, remote); err != nil {
+			return err
+		}
+	}
+	dashboardDB := db{Users: make(map[string]User, len(s.users)), Roles: s.roles, Version: 2}
+	res := storedDB{ext: map[string][]byte{}}
+	for username, usr := range s.users {
+		if ext := usr.ext(); !ext.empty() {
+			if res.ext[username], err = json.Marshal(ext); err != nil {
+				return err
+			}
+		}
+		usr.setExt(userExt{})
+		dashboardDB.Users[username] = usr
+	}
+	if res.raw, err = json.Marshal(&dashboardDB); err != nil {
 		return err
 	}
-	if len(res.Users) != 0 {
-		s.users = res.Users
+	if !bytes.Equal(res.raw, s.stored.raw) {
+		if _, err = s.radosSvc.ExecMonWithInputBuff(ctx, setDBMonCmd, res.raw); err != nil {
+			return err
+		}
+		stored, err := s.readDB(ctx)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(stored.raw, res.raw) {
+			return fmt.Errorf("%w: access db was overwritten concurrently", types.ErrAborted)
+		}
 	}
-	if len(res.Roles) != 0 {
-		s.roles = res.Roles
+	if err = s.storeUserExt(ctx, s.stored.ext, res.ext); err != nil {
+		return err
 	}
+	s.stored = res
 	return nil
 }
 
-func (s *Service) storeToDB(ctx context.Context) error {
-	res, err := json.Marshal(&db{Users: s.users, Roles: s.roles, Version: 2})
+// storeToDBOrRollback stores changes or reverts in-memory state to access DB content on error.
+func (s *Service) storeToDBOrRollback(ctx context.Context) error {
+	err := s.storeToDB(ctx)
 	if err != nil {
-		return err
+		if rollbackErr := s.updateFromDB(ctx); rollbackErr != nil {
+			zerolog.Ctx(ctx).Err(rollbackErr).Msg("unable to rollback access db")
+		}
 	}
-	_, err = s.radosSvc.ExecMonWithInputBuff(ctx, setDBMonCmd, res)
 	return err
 }
 
@@ -110,12 +261,23 @@ func (s *Service) GetUser(ctx context.Context, username string) (User, error) {
 func (s *Service) GetPermissions(ctx context.Context, username string) map[string][]string {
 	s.RLock()
 	defer s.RUnlock()
-	res := map[string][]string{}
 	usr, ok := s.users[username]
 	if !ok {
-		return res
+		return map[string][]string{}
 	}
-	for _, role := range usr.Roles {
+	return s.rolesPermissions(usr.Roles)
+}
+
+// GetRolesPermissions returns permissions granted by given roles. Unknown roles are ignored.
+func (s *Service) GetRolesPermissions(ctx context.Context, roles []string) map[string][]string {
+	s.RLock()
+	defer s.RUnlock()
+	return s.rolesPermissions(roles)
+}
+
+func (s *Service) rolesPermissions(roles []string) map[string][]string {
+	res := map[string][]string{}
+	for _, role := range roles {
 		for scope, perm := range s.roles[role].Permissions {
 			res[scope] = append(res[scope], perm...)
 		}
@@ -130,7 +292,13 @@ func (s *Service) GetPermissions(ctx context.Context, username string) map[strin
 	return res
 }
 
-func (s *Service) UpdateUser(ctx context.Context, user User) error {
+// UpdateUser updates user. If password or expiration date is not set, the previous one is kept.
+// New password without expiration date expires after password policy expiration span.
+// clearPwdExpiration removes password expiration date.
+func (s *Service) UpdateUser(ctx context.Context, user User, clearPwdExpiration bool) error {
+	if clearPwdExpiration && user.PwdExpirationDate != nil {
+		return fmt.Errorf("%w: password expiration date cannot be set and cleared at the same time", types.ErrInvalidArg)
+	}
 	s.Lock()
 	defer s.Unlock()
 	prev, ok := s.users[user.Username]
@@ -141,15 +309,27 @@ func (s *Service) UpdateUser(ctx context.Context, user User) error {
 		return err
 	}
 	if user.Password != "" {
-		pwdHash, err := bcrypt.GenerateFromPassword([]byte(user.Password), pwdBcryptCost)
+		if err := s.pwdPolicy.check(user.Username, user.Password, ""); err != nil {
+			return err
+		}
+		pwdHash, err := s.hashPassword(user.Password)
 		if err != nil {
 			return err
 		}
-		user.Password = string(pwdHash)
+		user.Password = pwdHash
+		if user.PwdExpirationDate == nil && !clearPwdExpiration {
+			user.PwdExpirationDate = s.pwdPolicy.expirationDate(time.Now())
+		}
 	} else {
 		user.Password = prev.Password
-		user.PwdExpirationDate = prev.PwdExpirationDate
+		if user.PwdExpirationDate == nil && !clearPwdExpiration {
+			user.PwdExpirationDate = prev.PwdExpirationDate
+		}
 	}
+	user.ExternalIssuer = prev.ExternalIssuer
+	user.ServiceAccount = prev.ServiceAccount
+	user.APIKeys = prev.APIKeys
+	user.MFA = prev.MFA
 	user.LastUpdate = int(time.Now().Unix())
 	s.users[user.Username] = user
 	err := s.storeToDB(ctx)
@@ -163,6 +343,70 @@ func (s *Service) UpdateUser(ctx context.Context, user User) error {
 	return nil
 }
 
+// SetEnabled enables or disables user login without changing other user settings.
+func (s *Service) SetEnabled(ctx context.Context, username string, enabled bool) error {
+	s.Lock()
+	defer s.Unlock()
+	user, ok := s.users[username]
+	if !ok {
+		return fmt.Errorf("%w: user %s", types.ErrNotFound, username)
+	}
+	if user.Enabled == enabled {
+		return nil
+	}
+	user.Enabled = enabled
+	user.LastUpdate = int(time.Now().Unix())
+	s.users[username] = user
+	return s.storeToDBOrRollback(ctx)
+}
+
+// EnsureExternalUser creates or updates user authenticated by external identity provider.
+// Existing local users and users of other providers cannot be taken over.
+func (s *Service) EnsureExternalUser(ctx context.Context, username, issuer string, roles []string) (User, error) {
+	s.Lock()
+	defer s.Unlock()
+	user, exists := s.users[username]
+	if exists && user.ExternalIssuer != issuer {
+		return User{}, fmt.Errorf("%w: user %s is not managed by issuer %s", types.ErrAccessDenied, username, issuer)
+	}
+	slices.Sort(roles)
+	if exists && slices.Equal(user.Roles, roles) {
+		return user, nil
+	}
+	if !exists {
+		// password login is not possible for external users
+		pwd := make([]byte, 32)
+		if _, err := rand.Read(pwd); err != nil {
+			return User{}, err
+		}
+		pwdHash, err := s.hashPassword(hex.EncodeToString(pwd))
+		if err != nil {
+			return User{}, err
+		}
+		user = User{
+			Username:       username,
+			Password:       pwdHash,
+			Enabled:        true,
+			ExternalIssuer: issuer,
+		}
+	}
+	user.Roles = roles
+	if err := s.validateUseRoles(user); err != nil {
+		return User{}, err
+	}
+	user.LastUpdate = int(time.Now().Unix())
+	s.users[username] = user
+	err := s.storeToDB(ctx)
+	if err != nil {
+		//rollback changes
+		if rollbackErr := s.updateFromDB(ctx); rollbackErr != nil {
+			zerolog.Ctx(ctx).Err(rollbackErr).Msg("unable to rollback access db")
+		}
+		return User{}, err
+	}
+	return user, nil
+}
+
 func (s *Service) validateUseRoles(u User) error {
 	for _, r := range u.Roles {
 		_, exists := systemRoleMap[r]
@@ -177,6 +421,16 @@ func (s *Service) validateUseRoles(u User) error {
 }
 
 func (s *Service) CreateUser(ctx context.Context, user User) error {
+	return s.createUser(ctx, user, false)
+}
+
+// ImportUser creates user with password hash migrated from other system instead of password.
+// Hash is replaced with bcrypt hash on the first successful login.
+func (s *Service) ImportUser(ctx context.Context, user User) error {
+	return s.createUser(ctx, user, true)
+}
+
+func (s *Service) createUser(ctx context.Context, user User, hashed bool) error {
 	s.Lock()
 	defer s.Unlock()
 	if err := user.Validate(); err != nil {
@@ -189,15 +443,26 @@ func (s *Service) CreateUser(ctx context.Context, user User) error {
 		return err
 	}
 	user.LastUpdate = int(time.Now().Unix())
-
-	pwdHash, err := bcrypt.GenerateFromPassword([]byte(user.Password), pwdBcryptCost)
-	if err != nil {
-		return err
+	if user.PwdExpirationDate == nil {
+		user.PwdExpirationDate = s.pwdPolicy.expirationDate(time.Now())
+	}
+	if hashed {
+		if err := ValidatePasswordHash(user.Password); err != nil {
+			return err
+		}
+	} else {
+		if err := s.pwdPolicy.check(user.Username, user.Password, ""); err != nil {
+			return err
+		}
+		pwdHash, err := s.hashPassword(user.Password)
+		if err != nil {
+			return err
+		}
+		user.Password = pwdHash
 	}
-	user.Password = string(pwdHash)
 
 	s.users[user.Username] = user
-	err = s.storeToDB(ctx)
+	err := s.storeToDB(ctx)
 	if err != nil {
 		//rollback changes
 		if rollbackErr := s.updateFromDB(ctx); rollbackErr != nil {
@@ -231,15 +496,24 @@ func (s *Service) ChangePassword(ctx context.Context, username, oldPass, newPass
 	if !ok {
 		return types.ErrNotFound
 	}
-	err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(oldPass))
-	if err != nil {
-		return fmt.Errorf("%w: invalid old password", err)
+	if user.ExternalIssuer != "" || user.ServiceAccount {
+		return fmt.Errorf("%w: user has no password", types.ErrInvalidArg)
+	}
+	if _, err := comparePassword(user.Password, oldPass, s.bcryptCost); err != nil {
+		return fmt.Errorf("%w: invalid old password", types.ErrInvalidArg)
+	}
+	if err := s.pwdPolicy.check(username, newPass, oldPass); err != nil {
+		return err
 	}
-	passHash, err := bcrypt.GenerateFromPassword([]byte(newPass), pwdBcryptCost)
+	passHash, err := s.hashPassword(newPass)
 	if err != nil {
 		return err
 	}
-	user.Password = string(passHash)
+	user.Password = passHash
+	// new password is not expired
+	user.PwdUpdateRequired = false
+	user.PwdExpirationDate = s.pwdPolicy.expirationDate(time.Now())
+	user.LastUpdate = int(time.Now().Unix())
 	s.users[username] = user
 	err = s.storeToDB(ctx)
 	if err != nil {
@@ -262,6 +536,26 @@ type User struct {
 	Enabled           bool     `json:"enabled"`
 	PwdExpirationDate *int     `json:"pwdExpirationDate"`
 	PwdUpdateRequired bool     `json:"pwdUpdateRequired"`
+	// ExternalIssuer - external identity provider of user provisioned on the first login.
+	ExternalIssuer string `json:"externalIssuer,omitempty"`
+	// ServiceAccount - user for automation authenticated only with API keys.
+	ServiceAccount bool     `json:"serviceAccount,omitempty"`
+	APIKeys        []APIKey `json:"apiKeys,omitempty"`
+	MFA            *MFA     `json:"mfa,omitempty"`
+}
+
+// PasswordExpired returns true if password expiration date has passed.
+func (u *User) PasswordExpired(now time.Time) bool {
+	return u.PwdExpirationDate != nil && int64(*u.PwdExpirationDate) <= now.Unix()
+}
+
+// PasswordChangeRequired returns true if user must change password before using API.
+// Users without password login are not affected.
+func (u *User) PasswordChangeRequired(now time.Time) bool {
+	if u.ExternalIssuer != "" || u.ServiceAccount {
+		return false
+	}
+	return u.PwdUpdateRequired || u.PasswordExpired(now)
 }
 
 func (u *User) Validate() error {
@@ -282,13 +576,24 @@ type Role struct {
 	Permissions map[string][]string `json:"scopes_permissions"`
 }
 
-func (r *Role) Validate() error {
+// Validate checks role name, scopes and permissions. Cluster scopes can refer only to given clusters.
+func (r *Role) Validate(clusters []string) error {
 	if r.Name == "" {
 		return fmt.Errorf("%w: role name is empty", types.ErrInvalidArg)
 	}
-	for scope, perms := range r.Permissions {
+	for key, perms := range r.Permissions {
+		scope, pattern, isResourceScope := strings.Cut(key, ":")
+		if isResourceScope {
+			if _, err := path.Match(pattern, ""); pattern == "" || err != nil {
+				return fmt.Errorf("%w: invalid resource pattern %q in scope %s", types.ErrInvalidArg, pattern, key)
+			}
+		}
+		scope, cluster, isClusterScope := strings.Cut(scope, "@")
+		if isClusterScope && !slices.Contains(clusters, cluster) {
+			return fmt.Errorf("%w: unknown cluster %q in scope %s, valid values: %+q", types.ErrInvalidArg, cluster, key, clusters)
+		}
 		if _, ok := scopeSet[scope]; !ok {
-			return fmt.Errorf("%w: unknown scope %s, valid values: %+q", types.ErrInvalidArg, scope, scopeSet)
+			return fmt.Errorf("%w: unknown scope %s in %s, valid values: %+q", types.ErrInvalidArg, scope, key, scopeSet)
 		}
 
 		for _, p := range perms {
@@ -341,7 +646,7 @@ func (s *Service) CreateRole(ctx context.Context, role Role) error {
 	if exists {
 		return types.ErrAlreadyExists
 	}
-	if err := role.Validate(); err != nil {
+	if err := role.Validate(s.clusters); err != nil {
 		return err
 	}
 	if role.IsSystem {
@@ -369,7 +674,7 @@ func (s *Service) UpdateRole(ctx context.Context, role Role) error {
 	if !exists {
 		return types.ErrNotFound
 	}
-	if err := role.Validate(); err != nil {
+	if err := role.Validate(s.clusters); err != nil {
 		return err
 	}
 	s.roles[role.Name] = role
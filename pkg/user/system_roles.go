@@ -43,6 +43,8 @@ var (
 		"dashboard-settings": {},
 		"nfs-ganesha":        {},
 		"nvme-of":            {},
+		// API audit log, not used by Ceph dashboard
+		"audit": {},
 	}
 )
 
@@ -67,6 +69,7 @@ const (
 	ScopeDashboardSettings Scope = "dashboard-setting"
 	ScopeNfsGanesha        Scope = "nfs-ganesha"
 	ScopeNvmeOf            Scope = "nvme-of"
+	ScopeAudit             Scope = "audit"
 )
 
 type Permission uint8
@@ -90,6 +93,12 @@ const (
 			"name": "administrator",
 			"description": "allows full permissions for all security scopes",
 			"scopes_permissions": {
+				"audit": [
+					"create",
+					"delete",
+					"read",
+					"update"
+				],
 				"cephfs": [
 					"create",
 					"delete",
@@ -0,0 +1,42 @@
+package test
+
+import (
+	"testing"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func Test_Audit_ListEvents(t *testing.T) {
+	r := require.New(t)
+	usersClient := pb.NewUsersClient(admConn)
+	const role = "e2e-audit-role"
+	_, err := usersClient.CreateRole(tstCtx, &pb.Role{Name: role})
+	r.NoError(err)
+	_, err = usersClient.DeleteRole(tstCtx, &pb.GetRoleReq{Name: role})
+	r.NoError(err)
+	_, err = usersClient.ListRoles(tstCtx, &emptypb.Empty{})
+	r.NoError(err)
+
+	client := pb.NewAuditClient(admConn)
+	method := "/ceph.Users/CreateRole"
+	res, err := client.ListAuditEvents(tstCtx, &pb.ListAuditEventsReq{Method: &method})
+	r.NoError(err)
+	r.True(res.ChainIntact)
+	r.NotEmpty(res.Events)
+	last := res.Events[len(res.Events)-1]
+	r.EqualValues(admin, last.Username)
+	r.EqualValues("OK", last.Code)
+	r.EqualValues(role, last.Resources["name"])
+	r.NotEmpty(last.Hash)
+
+	username, event := admin, "rpc"
+	res, err = client.ListAuditEvents(tstCtx, &pb.ListAuditEventsReq{Username: &username, Event: &event, Limit: 2})
+	r.NoError(err)
+	r.Len(res.Events, 2)
+	r.EqualValues("/ceph.Users/DeleteRole", res.Events[1].Method, "read methods are not audited")
+
+	_, err = pb.NewAuditClient(grpcConn).ListAuditEvents(tstCtx, &pb.ListAuditEventsReq{})
+	r.Error(err, "unauthenticated")
+}
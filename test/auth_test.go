@@ -3,10 +3,13 @@ package test
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"net/http"
 	"testing"
 
 	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/golang-jwt/jwt"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc/metadata"
@@ -58,6 +61,48 @@ func Test_Auth_Oauth_API(t *testing.T) {
 
 }
 
+func Test_Auth_JWKS(t *testing.T) {
+	r := require.New(t)
+	_, token, err := authenticateGrpcOauth(conf.App.AdminUsername, conf.App.AdminPassword)
+	r.NoError(err)
+
+	httpClient := http.DefaultClient
+	if conf.Api.Secure {
+		httpClient = &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}}
+	}
+	resp, err := httpClient.Get(httpAddr + "/.well-known/openid-configuration")
+	r.NoError(err)
+	defer resp.Body.Close()
+	r.EqualValues(http.StatusOK, resp.StatusCode)
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JwksURI string `json:"jwks_uri"`
+	}
+	r.NoError(json.NewDecoder(resp.Body).Decode(&discovery))
+	r.EqualValues(conf.Auth.Issuer, discovery.Issuer)
+	r.EqualValues(httpAddr+"/api/oauth/jwks", discovery.JwksURI)
+
+	resp, err = httpClient.Get(discovery.JwksURI)
+	r.NoError(err)
+	defer resp.Body.Close()
+	r.EqualValues(http.StatusOK, resp.StatusCode)
+	var jwks jose.JSONWebKeySet
+	r.NoError(json.NewDecoder(resp.Body).Decode(&jwks))
+	r.NotEmpty(jwks.Keys)
+
+	// access token can be verified offline with published key
+	parsed, err := jwt.Parse(token.AccessToken, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		keys := jwks.Key(kid)
+		r.Len(keys, 1, "token kid is published in jwks")
+		return keys[0].Key, nil
+	})
+	r.NoError(err)
+	r.True(parsed.Valid)
+}
+
 func authenticateGrpcOauth(login, pass string) (context.Context, *oauth2.Token, error) {
 	c := oauth2.Config{
 		ClientID: conf.Auth.ClientID,
@@ -80,3 +125,91 @@ func authenticateGrpcOauth(login, pass string) (context.Context, *oauth2.Token,
 	)
 	return metadata.NewOutgoingContext(context.Background(), md), token, nil
 }
+
+func Test_Auth_APIKey(t *testing.T) {
+	r := require.New(t)
+	const sa = "test-ci"
+	usersClient := pb.NewUsersClient(admConn)
+	clusterClient := pb.NewClusterClient(grpcConn)
+
+	_, err := usersClient.CreateServiceAccount(tstCtx, &pb.CreateServiceAccountReq{Username: sa, Roles: []string{"read-only"}})
+	r.NoError(err)
+	t.Cleanup(func() {
+		usersClient.DeleteUser(context.Background(), &pb.GetUserReq{Username: sa})
+	})
+	usr, err := usersClient.GetUser(tstCtx, &pb.GetUserReq{Username: sa})
+	r.NoError(err)
+	r.True(usr.ServiceAccount)
+
+	created, err := usersClient.CreateAPIKey(tstCtx, &pb.CreateAPIKeyReq{Username: sa, Name: "ci"})
+	r.NoError(err)
+	r.NotEmpty(created.Key)
+	r.EqualValues("ci", created.Info.Name)
+
+	// api key as bearer token and as header
+	bearerCtx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("Authorization", "Bearer "+created.Key))
+	_, err = clusterClient.GetStatus(bearerCtx, &emptypb.Empty{})
+	r.NoError(err)
+	headerCtx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("X-API-Key", created.Key))
+	_, err = clusterClient.GetStatus(headerCtx, &emptypb.Empty{})
+	r.NoError(err)
+	// permissions of service account roles are applied
+	_, err = pb.NewUsersClient(grpcConn).CreateServiceAccount(headerCtx, &pb.CreateServiceAccountReq{Username: sa + "-2"})
+	r.Error(err)
+
+	httpClient := http.DefaultClient
+	if conf.Api.Secure {
+		httpClient = &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}}
+	}
+	req, err := http.NewRequest(http.MethodGet, httpAddr+"/api/cluster", nil)
+	r.NoError(err)
+	req.Header.Set("X-API-Key", created.Key)
+	resp, err := httpClient.Do(req)
+	r.NoError(err)
+	resp.Body.Close()
+	r.EqualValues(http.StatusOK, resp.StatusCode)
+
+	keys, err := usersClient.ListAPIKeys(tstCtx, &pb.GetUserReq{Username: sa})
+	r.NoError(err)
+	r.Len(keys.Keys, 1)
+	r.NotNil(keys.Keys[0].LastUsed)
+
+	// password login is not possible for service account
+	_, _, err = authenticateGrpcOauth(sa, created.Key)
+	r.Error(err)
+
+	_, err = usersClient.RevokeAPIKey(tstCtx, &pb.RevokeAPIKeyReq{Username: sa, Name: "ci"})
+	r.NoError(err)
+	_, err = clusterClient.GetStatus(headerCtx, &emptypb.Empty{})
+	r.Error(err)
+}
+
+func Test_Auth_WhoAmI(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewAuthClient(admConn)
+
+	me, err := client.WhoAmI(tstCtx, &emptypb.Empty{})
+	r.NoError(err)
+	r.EqualValues(admin, me.Username)
+	r.Contains(me.Roles, "administrator")
+	r.Contains(me.Permissions, "pool")
+	r.NotNil(me.ExpiresAt)
+
+	res, err := client.CheckAccess(tstCtx, &pb.CheckAccessReq{Checks: []*pb.AccessCheck{
+		{Scope: "pool", Permission: "delete"},
+		{Scope: "pool", Permission: "read", Resource: "tenant-a-data"},
+	}})
+	r.NoError(err)
+	r.Len(res.Results, 2)
+	r.True(res.Results[0].Allowed)
+	r.EqualValues("delete", res.Results[0].Check.Permission)
+	r.True(res.Results[1].Allowed)
+
+	_, err = client.CheckAccess(tstCtx, &pb.CheckAccessReq{Checks: []*pb.AccessCheck{{Scope: "pool", Permission: "write"}}})
+	r.Error(err)
+
+	_, err = pb.NewAuthClient(grpcConn).WhoAmI(tstCtx, &emptypb.Empty{})
+	r.Error(err, "unauthenticated")
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"testing"
 
+	cephapi "github.com/clyso/ceph-api"
 	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -126,3 +129,28 @@ func Test_ClusterUsers(t *testing.T) {
 	}
 	r.NotNil(created, "user is back after import")
 }
+
+func Test_ListClusters(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewClusterClient(admConn)
+
+	res, err := client.ListClusters(tstCtx, &emptypb.Empty{})
+	r.NoError(err)
+	r.NotEmpty(res.Clusters)
+	def := res.Clusters[0]
+	r.EqualValues("default", def.Name)
+	r.True(def.IsDefault)
+	r.True(def.Connected)
+	r.NotEmpty(def.Fsid)
+	r.Contains(def.Health, "HEALTH_")
+	r.Empty(def.Error)
+
+	statusClient := pb.NewStatusClient(admConn)
+	cephStatus, err := statusClient.GetCephStatus(cephapi.WithCluster(tstCtx, "default"), &emptypb.Empty{})
+	r.NoError(err)
+	r.EqualValues(def.Fsid, cephStatus.Fsid)
+
+	_, err = statusClient.GetCephStatus(cephapi.WithCluster(tstCtx, "unknown-cluster"), &emptypb.Empty{})
+	r.Error(err)
+	r.EqualValues(codes.NotFound, status.Code(err))
+}
@@ -87,4 +87,35 @@ func Test_CreateRuleWithoutRequiredFields(t *testing.T) {
 	})
 	r.Error(err)
 	r.Contains(err.Error(), "InvalidArgument")
+
+	// replicated rule requires failure domain
+	_, err = client.CreateRule(tstCtx, &pb.CreateRuleRequest{
+		Name: "no-failure-domain",
+		Root: proto.String("default"),
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+
+	// erasure rule failure domain is defined by profile and cannot be set
+	_, err = client.CreateRule(tstCtx, &pb.CreateRuleRequest{
+		Name:          "ec-with-failure-domain",
+		PoolType:      pb.PoolType_erasure,
+		FailureDomain: "host",
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+}
+
+func Test_CreateRuleWithInvalidName(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewCrushRuleClient(admConn)
+
+	// name is validated against command signature before it is sent to mon
+	_, err := client.CreateRule(tstCtx, &pb.CreateRuleRequest{
+		Name:          `bad", "root": "other`,
+		Root:          proto.String("default"),
+		FailureDomain: "host",
+	})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -13,6 +14,7 @@ import (
 
 	cephapi "github.com/clyso/ceph-api"
 	"github.com/clyso/ceph-api/pkg/app"
+	"github.com/clyso/ceph-api/pkg/audit"
 	"github.com/clyso/ceph-api/pkg/config"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -47,6 +49,12 @@ func TestMain(m *testing.M) {
 	conf.App.AdminUsername = admin
 	conf.App.AdminPassword = pass
 	conf.App.BcryptPwdCost = 4
+	auditDir, err := os.MkdirTemp("", "ceph-api-audit")
+	if err != nil {
+		panic(err)
+	}
+	conf.Audit.Sink = audit.SinkFile
+	conf.Audit.File.Path = filepath.Join(auditDir, "audit.log")
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -95,6 +103,7 @@ func TestMain(m *testing.M) {
 	cancel()
 	grpcConn.Close()
 	c.Close()
+	os.RemoveAll(auditDir)
 	// exit
 	os.Exit(exitCode)
 }
@@ -104,6 +104,31 @@ func Test_Users_CRUD(t *testing.T) {
 	r.Error(err)
 }
 
+func Test_Users_PasswordPolicy(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewUsersClient(admConn)
+
+	// password validation does not require authentication
+	res, err := pb.NewUsersClient(grpcConn).ValidatePassword(tstCtx, &pb.ValidatePasswordReq{Username: "policy-user", Password: "short"})
+	r.NoError(err)
+	r.False(res.Valid)
+	r.Len(res.Violations, 1)
+	r.EqualValues("length", res.Violations[0].Rule)
+	res, err = client.ValidatePassword(tstCtx, &pb.ValidatePasswordReq{Username: "policy-user", Password: "long-enough-pass", OldPassword: "long-enough-pass"})
+	r.NoError(err)
+	r.False(res.Valid)
+	r.EqualValues("old_password", res.Violations[0].Rule)
+	res, err = client.ValidatePassword(tstCtx, &pb.ValidatePasswordReq{Username: "policy-user", Password: "long-enough-pass"})
+	r.NoError(err)
+	r.True(res.Valid)
+	r.Positive(res.Complexity)
+
+	_, err = client.CreateUser(tstCtx, &pb.CreateUserReq{Username: "policy-user", Password: "short", Enabled: true})
+	r.Error(err)
+	_, err = client.GetUser(tstCtx, &pb.GetUserReq{Username: "policy-user"})
+	r.Error(err, "user not created")
+}
+
 func Test_Roles_CRUD(t *testing.T) {
 	r := require.New(t)
 	client := pb.NewUsersClient(admConn)